@@ -9,11 +9,16 @@ import (
 	"github.com/dimazhornyk/generic-proving-network/internal/logic/handlers"
 	"github.com/dimazhornyk/generic-proving-network/internal/logic/sync"
 	"github.com/dimazhornyk/generic-proving-network/internal/presenters"
+	"github.com/dimazhornyk/generic-proving-network/internal/tracing"
 	"github.com/dimazhornyk/generic-proving-network/proto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
 	"log/slog"
 	"net"
+	"net/http"
 	"time"
 )
 
@@ -37,6 +42,7 @@ func buildApp(ctx context.Context) *fx.App {
 			connectors.NewPrivateKey,
 			connectors.NewHost,
 			connectors.NewEthereum,
+			connectors.NewStorageBackend,
 			logic.NewDHT,
 			logic.NewConnectionHolder,
 			logic.NewDiscovery,
@@ -44,17 +50,41 @@ func buildApp(ctx context.Context) *fx.App {
 			handlers.NewVotingHandler,
 			handlers.NewStatusUpdatesHandler,
 			handlers.NewProofsHandler,
+			handlers.NewDeadlineWatcher,
 			connectors.NewPubSub,
 			logic.NewNetworkParticipants,
+			logic.NewVoteWeigher,
 			logic.NewGlobalMessaging,
 			logic.NewStatusMap,
 			logic.NewStorage,
+			logic.NewProofLookup,
+			logic.NewStateCollector,
+			logic.NewEventBus,
+			logic.NewEvidenceCollector,
+			logic.NewCommitReveal,
+			logic.NewRandomnessSource,
+			logic.NewSelectionPolicy,
+			logic.NewCapacityMeter,
+			logic.NewJobQueue,
+			logic.NewConsumerLedger,
+			logic.NewProofSubmitter,
+			logic.NewPayoutTracker,
 			logic.NewService,
 			sync.NewInitialSyncer,
 			presenters.NewAPI,
 			presenters.NewListener,
 		),
-		fx.Invoke(common.InitGobModels),
+		// exports the traces, has to go before anything starts spans
+		fx.Invoke(func(ctx context.Context, lc fx.Lifecycle, cfg *common.Config, h host.Host) error {
+			provider, err := tracing.NewTracerProvider(ctx, cfg, h.ID().String())
+			if err != nil {
+				return fmt.Errorf("error creating a tracer provider: %w", err)
+			}
+
+			lc.Append(fx.StopHook(provider.Shutdown))
+
+			return nil
+		}),
 		// handles proofs generation, important for service to start first because it has to pull docker images
 		fx.Invoke(func(ctx context.Context, service *logic.Service) error {
 			return service.Start()
@@ -72,10 +102,64 @@ func buildApp(ctx context.Context) *fx.App {
 		fx.Invoke(func(ctx context.Context, cfg *common.Config, messaging *logic.StatusSharing) error {
 			return messaging.Init(ctx, cfg.Consumers)
 		}),
+		// computes the queued proofs, including the ones left from the previous run
+		fx.Invoke(func(ctx context.Context, service *logic.Service) {
+			go service.RunJobs(ctx)
+		}),
 		// provides data for initial sync for others
 		fx.Invoke(func(ctx context.Context, syncer *sync.InitialSyncer) {
 			syncer.ProvideData()
 		}),
+		// sends the contract calls, including the ones left from the previous run
+		fx.Invoke(func(ctx context.Context, eth *connectors.Ethereum) {
+			go eth.RunOutbox(ctx)
+		}),
+		// submits the finalized proofs that were waiting for their batch when the node stopped
+		fx.Invoke(func(ctx context.Context, submitter *logic.ProofSubmitter) {
+			go submitter.Resume(ctx)
+		}),
+		// claims the rewards of the submitted proofs once their claim windows are over
+		fx.Invoke(func(ctx context.Context, tracker *logic.PayoutTracker) {
+			go tracker.Run(ctx)
+		}),
+		// releases the reserved rewards once they're claimed or the requests fail on-chain
+		fx.Invoke(func(ctx context.Context, ledger *logic.ConsumerLedger) {
+			go ledger.Run(ctx)
+		}),
+		// drops the event histories of the old requests
+		fx.Invoke(func(ctx context.Context, events *logic.EventBus) {
+			go events.Run(ctx)
+		}),
+		// marks the silent peers unreachable and evicts them
+		fx.Invoke(func(ctx context.Context, nodes *logic.StatusMap) {
+			go nodes.Run(ctx)
+		}),
+		// re-selects the provers that miss the proving deadline
+		fx.Invoke(func(ctx context.Context, watcher *handlers.DeadlineWatcher) {
+			go watcher.Watch(ctx)
+		}),
+		// answers others' lookups of the finalized proofs
+		fx.Invoke(func(lookup *logic.ProofLookup) {
+			lookup.ProvideProofs()
+		}),
+		// exposes prometheus metrics
+		fx.Invoke(func(cfg *common.Config, collector *logic.StateCollector) error {
+			if err := prometheus.Register(collector); err != nil {
+				return fmt.Errorf("error registering state collector: %w", err)
+			}
+
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", promhttp.Handler())
+
+			go func() {
+				slog.Info("metrics server started", "port", cfg.MetricsPort)
+				if err := http.ListenAndServe(fmt.Sprintf(":%d", cfg.MetricsPort), mux); err != nil {
+					slog.Error("metrics server stopped", slog.String("err", err.Error()))
+				}
+			}()
+
+			return nil
+		}),
 		// starts grpc server
 		fx.Invoke(func(ctx context.Context, api *presenters.API) error {
 			listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
@@ -8,9 +8,16 @@ contract Network {
     uint256 public constant MIN_ETH_AMOUNT_CONSUMER = 1 ether;
     uint256 public constant MIN_ETH_AMOUNT_PROVER = 0.5 ether;
     uint256 private constant SECONDS_IN_DAY = 86400;
+    uint256 public constant SLASH_AMOUNT = 0.1 ether;
+    uint256 public constant MIN_INVALID_PROOF_VOTES = 2;
+    uint8 private constant SLASH_REASON_INVALID_PROOF = 0;
+    uint8 private constant SLASH_REASON_EQUIVOCATION = 1;
 
     event ProverUpdate(address addr, bool isAdded);
-    event ConsumerUpdate(address addr, bool isAdded);
+    event ConsumerUpdate(address addr, uint256 balance, string containerName, bool isAdded);
+    event RequestFailed(string requestId, address consumer, address[] provers);
+    event ProverSlashed(address prover, string requestId, uint8 reason, uint256 amount, address reporter);
+    event RewardClaimed(string requestId, address prover, uint256 amount);
 
     struct Consumer {
         uint256 balance;
@@ -37,6 +44,8 @@ contract Network {
         mapping(address => ProvingRewardClaim) claimers;
         address[] claimersAddresses;
         uint256 claimableAfterTimestamp;
+        uint256 reward;
+        bool claimed;
     }
 
     mapping(address => Consumer) public consumers;
@@ -48,6 +57,18 @@ contract Network {
     mapping(string => ProvingPayout) public payouts;
     string[] public payoutRequestIds;
 
+    mapping(string => bool) public failedRequests;
+
+    // the number of the provers with a deposit, every one of them but the prover validates its proof,
+    // the proofs need the majority of them to be paid or slashed
+    uint256 public activeProvers;
+
+    // keccak256(requestId, prover) => hash of the proof the failure report proved invalid
+    mapping(bytes32 => bytes32) public rejectedProofs;
+
+    // keccak256(requestId, offender, reason) => already slashed
+    mapping(bytes32 => bool) public slashed;
+
     modifier willHaveEnoughEth() {
         require(
             msg.value + consumers[msg.sender].balance >=
@@ -64,7 +85,7 @@ contract Network {
 
         consumers[msg.sender] = Consumer(msg.value, _containerName);
         consumerAddresses.push(msg.sender);
-        emit ConsumerUpdate(msg.sender, true);
+        emit ConsumerUpdate(msg.sender, msg.value, _containerName, true);
     }
 
     function depositEth() external payable willHaveEnoughEth {
@@ -79,7 +100,7 @@ contract Network {
         consumers[msg.sender] = Consumer(0, "");
 
         payable(msg.sender).transfer(balance);
-        emit ConsumerUpdate(msg.sender, false);
+        emit ConsumerUpdate(msg.sender, 0, "", false);
     }
 
     // registerProver requires a deposit from a prover to economically secure the network
@@ -89,6 +110,7 @@ contract Network {
 
         provers[msg.sender] = Prover(msg.value);
         proverAddresses.push(msg.sender);
+        activeProvers++;
 
         emit ProverUpdate(msg.sender, true);
     }
@@ -109,6 +131,7 @@ contract Network {
 
         uint256 balance = provers[msg.sender].balance;
         provers[msg.sender] = Prover(0);
+        activeProvers--;
 
         payable(msg.sender).transfer(balance);
 
@@ -141,9 +164,12 @@ contract Network {
         return result;
     }
 
+    // validationOutputToJson is the message signed by the validators, proofHash is keccak256 of the proof,
+    // so a signature can't be attached to a proof other than the validated one
     function validationOutputToJson(
         string memory requestId,
         address proverAddress,
+        bytes32 proofHash,
         bool isValid
     ) internal pure returns (bytes memory) {
         return
@@ -152,25 +178,74 @@ contract Network {
                 requestId,
                 '","prover_address":"',
                 Strings.toHexString(uint160(proverAddress), 20),
+                '","proof_hash":"',
+                Strings.toHexString(uint256(proofHash), 32),
                 '","is_valid":',
                 isValid ? "true" : "false",
                 "}"
             );
     }
 
-    // rs[0], ss[0], vs[0] are consumer parameters of a signature of the request
-    // TODO: make it callable more than once to increase the number of validations in case of any malicious actions from
-    // other participants of the network, check if no one signed more than one message
+    // rs[0], ss[0], vs[0] are consumer parameters of a signature of the request, they are followed by the positive
+    // validation signatures sorted by the validator's address, the request is recorded once
     function submitSignedProof(
         string calldata requestId,
         uint256 reward,
+        bytes32 proofHash,
+        bytes32[] calldata rs,
+        bytes32[] calldata ss,
+        uint8[] calldata vs
+    ) external {
+        require(rs.length == ss.length);
+        require(vs.length == ss.length);
+
+        recordSignedProof(requestId, reward, proofHash, rs, ss, vs);
+    }
+
+    // submitSignedProofs is submitSignedProof for several requests in one transaction, the signatures of the requests
+    // are concatenated: signaturesCounts[i] of them, starting with the consumer's one, belong to requestIds[i].
+    // The whole batch reverts if any of the requests is invalid
+    function submitSignedProofs(
+        string[] calldata requestIds,
+        uint256[] calldata rewards,
+        bytes32[] calldata proofHashes,
+        uint8[] calldata signaturesCounts,
         bytes32[] calldata rs,
         bytes32[] calldata ss,
         uint8[] calldata vs
     ) external {
+        require(requestIds.length == rewards.length);
+        require(requestIds.length == proofHashes.length);
+        require(requestIds.length == signaturesCounts.length);
         require(rs.length == ss.length);
         require(vs.length == ss.length);
 
+        uint256 offset = 0;
+        for (uint256 i = 0; i < requestIds.length; ++i) {
+            uint256 end = offset + signaturesCounts[i];
+            require(signaturesCounts[i] != 0 && end <= rs.length);
+
+            recordSignedProof(
+                requestIds[i],
+                rewards[i],
+                proofHashes[i],
+                rs[offset:end],
+                ss[offset:end],
+                vs[offset:end]
+            );
+            offset = end;
+        }
+        require(offset == rs.length);
+    }
+
+    function recordSignedProof(
+        string calldata requestId,
+        uint256 reward,
+        bytes32 proofHash,
+        bytes32[] calldata rs,
+        bytes32[] calldata ss,
+        uint8[] calldata vs
+    ) internal {
         address consumer = ecrecover(
             keccak256(abi.encodePacked(requestId, reward)),
             vs[0],
@@ -178,24 +253,33 @@ contract Network {
             ss[0]
         );
         require(consumers[consumer].balance != 0);
+        require(payouts[requestId].consumer == address(0));
+        require(!failedRequests[requestId]);
 
         uint16 validationsCnt = 0;
         {
             bytes memory json = validationOutputToJson(
                 requestId,
                 msg.sender,
+                proofHash,
                 true
             );
             bytes32 hash = keccak256(json);
 
+            address last = address(0);
             for (uint256 i = 1; i < rs.length; ++i) {
                 address validator = ecrecover(hash, vs[i], rs[i], ss[i]);
-                if (provers[validator].balance != 0) {
+                require(validator > last);
+                last = validator;
+
+                // the prover doesn't validate its own proof
+                if (validator != msg.sender && provers[validator].balance != 0) {
                     // TODO: add incentive for validators
                     validationsCnt++;
                 }
             }
         }
+        require(validationsCnt >= validationQuorum(msg.sender));
 
         payouts[requestId].consumer = consumer;
         payouts[requestId].claimersAddresses.push(msg.sender);
@@ -203,5 +287,203 @@ contract Network {
             block.timestamp +
             SECONDS_IN_DAY;
         payouts[requestId].claimers[msg.sender].validations = validationsCnt;
+        payouts[requestId].reward = reward;
+    }
+
+    // claimReward moves the reward of the request from the consumer's balance to the prover's one once the claim
+    // window is over, only a claimer whose proof was validated can claim, the reward is paid once
+    function claimReward(string calldata requestId) external {
+        ProvingPayout storage payout = payouts[requestId];
+        require(payout.consumer != address(0));
+        require(block.timestamp >= payout.claimableAfterTimestamp);
+        require(!payout.claimed);
+        require(payout.claimers[msg.sender].validations != 0);
+        require(provers[msg.sender].balance != 0);
+
+        payout.claimed = true;
+
+        uint256 amount = payout.reward;
+        if (consumers[payout.consumer].balance < amount) {
+            amount = consumers[payout.consumer].balance;
+        }
+
+        consumers[payout.consumer].balance -= amount;
+        provers[msg.sender].balance += amount;
+
+        emit RewardClaimed(requestId, msg.sender, amount);
+    }
+
+    // reportFailedRequest records that none of the selected provers delivered a valid proof,
+    // rs[0], ss[0], vs[0] are consumer parameters of a signature of the request, they are followed by
+    // signaturesCounts[i] negative validation signatures of proofHashes[i] by failedProvers[i] sorted by the validator's
+    // address, a prover that missed the deadline has no signatures, the others need MIN_INVALID_PROOF_VOTES of them
+    function reportFailedRequest(
+        string calldata requestId,
+        uint256 reward,
+        address[] calldata failedProvers,
+        bytes32[] calldata proofHashes,
+        uint8[] calldata signaturesCounts,
+        bytes32[] calldata rs,
+        bytes32[] calldata ss,
+        uint8[] calldata vs
+    ) external {
+        require(rs.length == ss.length);
+        require(vs.length == ss.length);
+        require(failedProvers.length == signaturesCounts.length);
+        require(failedProvers.length == proofHashes.length);
+        require(provers[msg.sender].balance != 0);
+        require(!failedRequests[requestId]);
+        require(payouts[requestId].consumer == address(0));
+
+        address consumer = ecrecover(
+            keccak256(abi.encodePacked(requestId, reward)),
+            vs[0],
+            rs[0],
+            ss[0]
+        );
+        require(consumers[consumer].balance != 0);
+
+        uint256 offset = 1;
+        bool hasRejectedProof = false;
+        for (uint256 i = 0; i < failedProvers.length; ++i) {
+            if (signaturesCounts[i] == 0) {
+                continue;
+            }
+
+            uint256 end = offset + signaturesCounts[i];
+            require(end <= rs.length);
+            require(
+                countInvalidProofVotes(
+                    requestId,
+                    failedProvers[i],
+                    proofHashes[i],
+                    rs[offset:end],
+                    ss[offset:end],
+                    vs[offset:end]
+                ) >= MIN_INVALID_PROOF_VOTES
+            );
+            hasRejectedProof = true;
+            rejectedProofs[keccak256(abi.encodePacked(requestId, failedProvers[i]))] = proofHashes[i];
+
+            offset = end;
+        }
+        require(offset == rs.length);
+        require(hasRejectedProof);
+
+        failedRequests[requestId] = true;
+        emit RequestFailed(requestId, consumer, failedProvers);
+    }
+
+    // slashInvalidProof punishes the prover of the failed request whose proof was rejected by the failure report,
+    // once the majority of the validators voted the proof invalid. The signatures have to be sorted by the validator's
+    // address to rule out duplicates
+    function slashInvalidProof(
+        string calldata requestId,
+        address prover,
+        bytes32 proofHash,
+        bytes32[] calldata rs,
+        bytes32[] calldata ss,
+        uint8[] calldata vs
+    ) external {
+        require(rs.length == ss.length);
+        require(vs.length == ss.length);
+        require(failedRequests[requestId]);
+        require(proofHash != bytes32(0));
+        require(rejectedProofs[keccak256(abi.encodePacked(requestId, prover))] == proofHash);
+        require(
+            countInvalidProofVotes(requestId, prover, proofHash, rs, ss, vs) >=
+                validationQuorum(prover)
+        );
+
+        slash(prover, requestId, SLASH_REASON_INVALID_PROOF);
+    }
+
+    // validationQuorum is the majority of the validators of the prover's proof, all the other registered provers
+    function validationQuorum(address prover) public view returns (uint256) {
+        uint256 validators = activeProvers;
+        if (provers[prover].balance != 0) {
+            validators--;
+        }
+
+        return validators / 2 + 1;
+    }
+
+    // countInvalidProofVotes counts the registered validators other than the prover that voted the proof invalid,
+    // the signatures have to be sorted by the validator's address to rule out duplicates
+    function countInvalidProofVotes(
+        string calldata requestId,
+        address prover,
+        bytes32 proofHash,
+        bytes32[] calldata rs,
+        bytes32[] calldata ss,
+        uint8[] calldata vs
+    ) internal view returns (uint256 votes) {
+        bytes32 hash = keccak256(
+            validationOutputToJson(requestId, prover, proofHash, false)
+        );
+
+        address last = address(0);
+        for (uint256 i = 0; i < rs.length; ++i) {
+            address validator = ecrecover(hash, vs[i], rs[i], ss[i]);
+            require(validator > last);
+            last = validator;
+
+            if (validator != prover && provers[validator].balance != 0) {
+                votes++;
+            }
+        }
+    }
+
+    // slashEquivocation punishes the validator that signed both the positive (index 0)
+    // and the negative (index 1) vote for the same proof
+    function slashEquivocation(
+        string calldata requestId,
+        address prover,
+        bytes32 proofHash,
+        bytes32[2] calldata rs,
+        bytes32[2] calldata ss,
+        uint8[2] calldata vs
+    ) external {
+        address validator = ecrecover(
+            keccak256(validationOutputToJson(requestId, prover, proofHash, true)),
+            vs[0],
+            rs[0],
+            ss[0]
+        );
+        require(validator != address(0));
+        require(
+            validator ==
+                ecrecover(
+                    keccak256(validationOutputToJson(requestId, prover, proofHash, false)),
+                    vs[1],
+                    rs[1],
+                    ss[1]
+                )
+        );
+
+        slash(validator, requestId, SLASH_REASON_EQUIVOCATION);
+    }
+
+    // slash takes SLASH_AMOUNT from the offender's deposit, half of it goes to the reporter, the rest stays locked
+    function slash(address offender, string calldata requestId, uint8 reason) internal {
+        require(provers[msg.sender].balance != 0);
+
+        bytes32 key = keccak256(abi.encodePacked(requestId, offender, reason));
+        require(!slashed[key]);
+        slashed[key] = true;
+
+        uint256 amount = SLASH_AMOUNT;
+        if (provers[offender].balance < amount) {
+            amount = provers[offender].balance;
+        }
+        require(amount != 0);
+
+        provers[offender].balance -= amount;
+        provers[msg.sender].balance += amount / 2;
+        if (provers[offender].balance == 0) {
+            activeProvers--;
+        }
+
+        emit ProverSlashed(offender, requestId, reason, amount, msg.sender);
     }
 }
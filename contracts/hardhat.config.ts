@@ -2,7 +2,18 @@ import {HardhatUserConfig} from "hardhat/config";
 import "@nomicfoundation/hardhat-toolbox";
 
 const config: HardhatUserConfig = {
-    solidity: "0.8.22",
+    solidity: {
+        version: "0.8.30",
+        settings: {
+            evmVersion: "paris",
+            // the functions taking the batches of signatures need more stack than the legacy pipeline gives them
+            viaIR: true,
+            optimizer: {
+                enabled: true,
+                runs: 200,
+            },
+        },
+    },
 };
 
 export default config;
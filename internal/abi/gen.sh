@@ -1 +1,3 @@
+# the ABI is taken from the artifact built by `npx hardhat compile` in contracts/
+jq --tab .abi ../../contracts/artifacts/contracts/gpn-core.sol/Network.json > gpn.abi
 abigen --abi gpn.abi --pkg gpn --type ProvingNetwork --out gpn.go
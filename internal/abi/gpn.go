@@ -38,7 +38,7 @@ type NetworkConsumerView struct {
 
 // ProvingNetworkMetaData contains all meta data concerning the ProvingNetwork contract.
 var ProvingNetworkMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}],\"name\":\"StringsInsufficientHexLength\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"containerName\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"isAdded\",\"type\":\"bool\"}],\"name\":\"ConsumerUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"isAdded\",\"type\":\"bool\"}],\"name\":\"ProverUpdate\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MIN_ETH_AMOUNT_CONSUMER\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MIN_ETH_AMOUNT_PROVER\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"consumerAddresses\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"consumers\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"containerName\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"depositEth\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getConsumers\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"containerName\",\"type\":\"string\"}],\"internalType\":\"structNetwork.ConsumerView[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getProvers\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"payoutRequestIds\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"payouts\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"consumer\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"claimableAfterTimestamp\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"proverAddresses\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"provers\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_containerName\",\"type\":\"string\"}],\"name\":\"registerConsumer\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"registerProver\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"reward\",\"type\":\"uint256\"},{\"internalType\":\"bytes32[]\",\"name\":\"rs\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"ss\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint8[]\",\"name\":\"vs\",\"type\":\"uint8[]\"}],\"name\":\"submitSignedProof\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawConsumer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawProver\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawRewards\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}],\"name\":\"StringsInsufficientHexLength\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"containerName\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"isAdded\",\"type\":\"bool\"}],\"name\":\"ConsumerUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"prover\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"requestId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"reason\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"reporter\",\"type\":\"address\"}],\"name\":\"ProverSlashed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"isAdded\",\"type\":\"bool\"}],\"name\":\"ProverUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"requestId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"consumer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"provers\",\"type\":\"address[]\"}],\"name\":\"RequestFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"requestId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"prover\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"RewardClaimed\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MIN_ETH_AMOUNT_CONSUMER\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MIN_ETH_AMOUNT_PROVER\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MIN_INVALID_PROOF_VOTES\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"SLASH_AMOUNT\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"activeProvers\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestId\",\"type\":\"string\"}],\"name\":\"claimReward\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"consumerAddresses\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"consumers\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"containerName\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"depositEth\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"failedRequests\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getConsumers\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"containerName\",\"type\":\"string\"}],\"internalType\":\"structNetwork.ConsumerView[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getProvers\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"payoutRequestIds\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"payouts\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"consumer\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"claimableAfterTimestamp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"reward\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"claimed\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"proverAddresses\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"provers\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_containerName\",\"type\":\"string\"}],\"name\":\"registerConsumer\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"registerProver\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"rejectedProofs\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"reward\",\"type\":\"uint256\"},{\"internalType\":\"address[]\",\"name\":\"failedProvers\",\"type\":\"address[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"proofHashes\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint8[]\",\"name\":\"signaturesCounts\",\"type\":\"uint8[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"rs\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"ss\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint8[]\",\"name\":\"vs\",\"type\":\"uint8[]\"}],\"name\":\"reportFailedRequest\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"prover\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"proofHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32[2]\",\"name\":\"rs\",\"type\":\"bytes32[2]\"},{\"internalType\":\"bytes32[2]\",\"name\":\"ss\",\"type\":\"bytes32[2]\"},{\"internalType\":\"uint8[2]\",\"name\":\"vs\",\"type\":\"uint8[2]\"}],\"name\":\"slashEquivocation\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"prover\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"proofHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32[]\",\"name\":\"rs\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"ss\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint8[]\",\"name\":\"vs\",\"type\":\"uint8[]\"}],\"name\":\"slashInvalidProof\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"slashed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"reward\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"proofHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32[]\",\"name\":\"rs\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"ss\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint8[]\",\"name\":\"vs\",\"type\":\"uint8[]\"}],\"name\":\"submitSignedProof\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string[]\",\"name\":\"requestIds\",\"type\":\"string[]\"},{\"internalType\":\"uint256[]\",\"name\":\"rewards\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"proofHashes\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint8[]\",\"name\":\"signaturesCounts\",\"type\":\"uint8[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"rs\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"ss\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint8[]\",\"name\":\"vs\",\"type\":\"uint8[]\"}],\"name\":\"submitSignedProofs\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"prover\",\"type\":\"address\"}],\"name\":\"validationQuorum\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawConsumer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawProver\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawRewards\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // ProvingNetworkABI is the input ABI used to generate the binding from.
@@ -249,6 +249,99 @@ func (_ProvingNetwork *ProvingNetworkCallerSession) MINETHAMOUNTPROVER() (*big.I
 	return _ProvingNetwork.Contract.MINETHAMOUNTPROVER(&_ProvingNetwork.CallOpts)
 }
 
+// MININVALIDPROOFVOTES is a free data retrieval call binding the contract method 0xef5c5653.
+//
+// Solidity: function MIN_INVALID_PROOF_VOTES() view returns(uint256)
+func (_ProvingNetwork *ProvingNetworkCaller) MININVALIDPROOFVOTES(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ProvingNetwork.contract.Call(opts, &out, "MIN_INVALID_PROOF_VOTES")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MININVALIDPROOFVOTES is a free data retrieval call binding the contract method 0xef5c5653.
+//
+// Solidity: function MIN_INVALID_PROOF_VOTES() view returns(uint256)
+func (_ProvingNetwork *ProvingNetworkSession) MININVALIDPROOFVOTES() (*big.Int, error) {
+	return _ProvingNetwork.Contract.MININVALIDPROOFVOTES(&_ProvingNetwork.CallOpts)
+}
+
+// MININVALIDPROOFVOTES is a free data retrieval call binding the contract method 0xef5c5653.
+//
+// Solidity: function MIN_INVALID_PROOF_VOTES() view returns(uint256)
+func (_ProvingNetwork *ProvingNetworkCallerSession) MININVALIDPROOFVOTES() (*big.Int, error) {
+	return _ProvingNetwork.Contract.MININVALIDPROOFVOTES(&_ProvingNetwork.CallOpts)
+}
+
+// SLASHAMOUNT is a free data retrieval call binding the contract method 0x37720606.
+//
+// Solidity: function SLASH_AMOUNT() view returns(uint256)
+func (_ProvingNetwork *ProvingNetworkCaller) SLASHAMOUNT(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ProvingNetwork.contract.Call(opts, &out, "SLASH_AMOUNT")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SLASHAMOUNT is a free data retrieval call binding the contract method 0x37720606.
+//
+// Solidity: function SLASH_AMOUNT() view returns(uint256)
+func (_ProvingNetwork *ProvingNetworkSession) SLASHAMOUNT() (*big.Int, error) {
+	return _ProvingNetwork.Contract.SLASHAMOUNT(&_ProvingNetwork.CallOpts)
+}
+
+// SLASHAMOUNT is a free data retrieval call binding the contract method 0x37720606.
+//
+// Solidity: function SLASH_AMOUNT() view returns(uint256)
+func (_ProvingNetwork *ProvingNetworkCallerSession) SLASHAMOUNT() (*big.Int, error) {
+	return _ProvingNetwork.Contract.SLASHAMOUNT(&_ProvingNetwork.CallOpts)
+}
+
+// ActiveProvers is a free data retrieval call binding the contract method 0x1bb2fc05.
+//
+// Solidity: function activeProvers() view returns(uint256)
+func (_ProvingNetwork *ProvingNetworkCaller) ActiveProvers(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ProvingNetwork.contract.Call(opts, &out, "activeProvers")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ActiveProvers is a free data retrieval call binding the contract method 0x1bb2fc05.
+//
+// Solidity: function activeProvers() view returns(uint256)
+func (_ProvingNetwork *ProvingNetworkSession) ActiveProvers() (*big.Int, error) {
+	return _ProvingNetwork.Contract.ActiveProvers(&_ProvingNetwork.CallOpts)
+}
+
+// ActiveProvers is a free data retrieval call binding the contract method 0x1bb2fc05.
+//
+// Solidity: function activeProvers() view returns(uint256)
+func (_ProvingNetwork *ProvingNetworkCallerSession) ActiveProvers() (*big.Int, error) {
+	return _ProvingNetwork.Contract.ActiveProvers(&_ProvingNetwork.CallOpts)
+}
+
 // ConsumerAddresses is a free data retrieval call binding the contract method 0x47ee6e3c.
 //
 // Solidity: function consumerAddresses(uint256 ) view returns(address)
@@ -325,6 +418,37 @@ func (_ProvingNetwork *ProvingNetworkCallerSession) Consumers(arg0 common.Addres
 	return _ProvingNetwork.Contract.Consumers(&_ProvingNetwork.CallOpts, arg0)
 }
 
+// FailedRequests is a free data retrieval call binding the contract method 0xe94bbd9b.
+//
+// Solidity: function failedRequests(string ) view returns(bool)
+func (_ProvingNetwork *ProvingNetworkCaller) FailedRequests(opts *bind.CallOpts, arg0 string) (bool, error) {
+	var out []interface{}
+	err := _ProvingNetwork.contract.Call(opts, &out, "failedRequests", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// FailedRequests is a free data retrieval call binding the contract method 0xe94bbd9b.
+//
+// Solidity: function failedRequests(string ) view returns(bool)
+func (_ProvingNetwork *ProvingNetworkSession) FailedRequests(arg0 string) (bool, error) {
+	return _ProvingNetwork.Contract.FailedRequests(&_ProvingNetwork.CallOpts, arg0)
+}
+
+// FailedRequests is a free data retrieval call binding the contract method 0xe94bbd9b.
+//
+// Solidity: function failedRequests(string ) view returns(bool)
+func (_ProvingNetwork *ProvingNetworkCallerSession) FailedRequests(arg0 string) (bool, error) {
+	return _ProvingNetwork.Contract.FailedRequests(&_ProvingNetwork.CallOpts, arg0)
+}
+
 // GetConsumers is a free data retrieval call binding the contract method 0x3b729b86.
 //
 // Solidity: function getConsumers() view returns((address,uint256,string)[])
@@ -420,10 +544,12 @@ func (_ProvingNetwork *ProvingNetworkCallerSession) PayoutRequestIds(arg0 *big.I
 
 // Payouts is a free data retrieval call binding the contract method 0xd7be0a06.
 //
-// Solidity: function payouts(string ) view returns(address consumer, uint256 claimableAfterTimestamp)
+// Solidity: function payouts(string ) view returns(address consumer, uint256 claimableAfterTimestamp, uint256 reward, bool claimed)
 func (_ProvingNetwork *ProvingNetworkCaller) Payouts(opts *bind.CallOpts, arg0 string) (struct {
 	Consumer                common.Address
 	ClaimableAfterTimestamp *big.Int
+	Reward                  *big.Int
+	Claimed                 bool
 }, error) {
 	var out []interface{}
 	err := _ProvingNetwork.contract.Call(opts, &out, "payouts", arg0)
@@ -431,6 +557,8 @@ func (_ProvingNetwork *ProvingNetworkCaller) Payouts(opts *bind.CallOpts, arg0 s
 	outstruct := new(struct {
 		Consumer                common.Address
 		ClaimableAfterTimestamp *big.Int
+		Reward                  *big.Int
+		Claimed                 bool
 	})
 	if err != nil {
 		return *outstruct, err
@@ -438,6 +566,8 @@ func (_ProvingNetwork *ProvingNetworkCaller) Payouts(opts *bind.CallOpts, arg0 s
 
 	outstruct.Consumer = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
 	outstruct.ClaimableAfterTimestamp = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Reward = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.Claimed = *abi.ConvertType(out[3], new(bool)).(*bool)
 
 	return *outstruct, err
 
@@ -445,20 +575,24 @@ func (_ProvingNetwork *ProvingNetworkCaller) Payouts(opts *bind.CallOpts, arg0 s
 
 // Payouts is a free data retrieval call binding the contract method 0xd7be0a06.
 //
-// Solidity: function payouts(string ) view returns(address consumer, uint256 claimableAfterTimestamp)
+// Solidity: function payouts(string ) view returns(address consumer, uint256 claimableAfterTimestamp, uint256 reward, bool claimed)
 func (_ProvingNetwork *ProvingNetworkSession) Payouts(arg0 string) (struct {
 	Consumer                common.Address
 	ClaimableAfterTimestamp *big.Int
+	Reward                  *big.Int
+	Claimed                 bool
 }, error) {
 	return _ProvingNetwork.Contract.Payouts(&_ProvingNetwork.CallOpts, arg0)
 }
 
 // Payouts is a free data retrieval call binding the contract method 0xd7be0a06.
 //
-// Solidity: function payouts(string ) view returns(address consumer, uint256 claimableAfterTimestamp)
+// Solidity: function payouts(string ) view returns(address consumer, uint256 claimableAfterTimestamp, uint256 reward, bool claimed)
 func (_ProvingNetwork *ProvingNetworkCallerSession) Payouts(arg0 string) (struct {
 	Consumer                common.Address
 	ClaimableAfterTimestamp *big.Int
+	Reward                  *big.Int
+	Claimed                 bool
 }, error) {
 	return _ProvingNetwork.Contract.Payouts(&_ProvingNetwork.CallOpts, arg0)
 }
@@ -525,6 +659,120 @@ func (_ProvingNetwork *ProvingNetworkCallerSession) Provers(arg0 common.Address)
 	return _ProvingNetwork.Contract.Provers(&_ProvingNetwork.CallOpts, arg0)
 }
 
+// RejectedProofs is a free data retrieval call binding the contract method 0xa04803c1.
+//
+// Solidity: function rejectedProofs(bytes32 ) view returns(bytes32)
+func (_ProvingNetwork *ProvingNetworkCaller) RejectedProofs(opts *bind.CallOpts, arg0 [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _ProvingNetwork.contract.Call(opts, &out, "rejectedProofs", arg0)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// RejectedProofs is a free data retrieval call binding the contract method 0xa04803c1.
+//
+// Solidity: function rejectedProofs(bytes32 ) view returns(bytes32)
+func (_ProvingNetwork *ProvingNetworkSession) RejectedProofs(arg0 [32]byte) ([32]byte, error) {
+	return _ProvingNetwork.Contract.RejectedProofs(&_ProvingNetwork.CallOpts, arg0)
+}
+
+// RejectedProofs is a free data retrieval call binding the contract method 0xa04803c1.
+//
+// Solidity: function rejectedProofs(bytes32 ) view returns(bytes32)
+func (_ProvingNetwork *ProvingNetworkCallerSession) RejectedProofs(arg0 [32]byte) ([32]byte, error) {
+	return _ProvingNetwork.Contract.RejectedProofs(&_ProvingNetwork.CallOpts, arg0)
+}
+
+// Slashed is a free data retrieval call binding the contract method 0x61b143df.
+//
+// Solidity: function slashed(bytes32 ) view returns(bool)
+func (_ProvingNetwork *ProvingNetworkCaller) Slashed(opts *bind.CallOpts, arg0 [32]byte) (bool, error) {
+	var out []interface{}
+	err := _ProvingNetwork.contract.Call(opts, &out, "slashed", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Slashed is a free data retrieval call binding the contract method 0x61b143df.
+//
+// Solidity: function slashed(bytes32 ) view returns(bool)
+func (_ProvingNetwork *ProvingNetworkSession) Slashed(arg0 [32]byte) (bool, error) {
+	return _ProvingNetwork.Contract.Slashed(&_ProvingNetwork.CallOpts, arg0)
+}
+
+// Slashed is a free data retrieval call binding the contract method 0x61b143df.
+//
+// Solidity: function slashed(bytes32 ) view returns(bool)
+func (_ProvingNetwork *ProvingNetworkCallerSession) Slashed(arg0 [32]byte) (bool, error) {
+	return _ProvingNetwork.Contract.Slashed(&_ProvingNetwork.CallOpts, arg0)
+}
+
+// ValidationQuorum is a free data retrieval call binding the contract method 0x260a2f8e.
+//
+// Solidity: function validationQuorum(address prover) view returns(uint256)
+func (_ProvingNetwork *ProvingNetworkCaller) ValidationQuorum(opts *bind.CallOpts, prover common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ProvingNetwork.contract.Call(opts, &out, "validationQuorum", prover)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ValidationQuorum is a free data retrieval call binding the contract method 0x260a2f8e.
+//
+// Solidity: function validationQuorum(address prover) view returns(uint256)
+func (_ProvingNetwork *ProvingNetworkSession) ValidationQuorum(prover common.Address) (*big.Int, error) {
+	return _ProvingNetwork.Contract.ValidationQuorum(&_ProvingNetwork.CallOpts, prover)
+}
+
+// ValidationQuorum is a free data retrieval call binding the contract method 0x260a2f8e.
+//
+// Solidity: function validationQuorum(address prover) view returns(uint256)
+func (_ProvingNetwork *ProvingNetworkCallerSession) ValidationQuorum(prover common.Address) (*big.Int, error) {
+	return _ProvingNetwork.Contract.ValidationQuorum(&_ProvingNetwork.CallOpts, prover)
+}
+
+// ClaimReward is a paid mutator transaction binding the contract method 0xbb8c9797.
+//
+// Solidity: function claimReward(string requestId) returns()
+func (_ProvingNetwork *ProvingNetworkTransactor) ClaimReward(opts *bind.TransactOpts, requestId string) (*types.Transaction, error) {
+	return _ProvingNetwork.contract.Transact(opts, "claimReward", requestId)
+}
+
+// ClaimReward is a paid mutator transaction binding the contract method 0xbb8c9797.
+//
+// Solidity: function claimReward(string requestId) returns()
+func (_ProvingNetwork *ProvingNetworkSession) ClaimReward(requestId string) (*types.Transaction, error) {
+	return _ProvingNetwork.Contract.ClaimReward(&_ProvingNetwork.TransactOpts, requestId)
+}
+
+// ClaimReward is a paid mutator transaction binding the contract method 0xbb8c9797.
+//
+// Solidity: function claimReward(string requestId) returns()
+func (_ProvingNetwork *ProvingNetworkTransactorSession) ClaimReward(requestId string) (*types.Transaction, error) {
+	return _ProvingNetwork.Contract.ClaimReward(&_ProvingNetwork.TransactOpts, requestId)
+}
+
 // DepositEth is a paid mutator transaction binding the contract method 0x439370b1.
 //
 // Solidity: function depositEth() payable returns()
@@ -588,25 +836,109 @@ func (_ProvingNetwork *ProvingNetworkTransactorSession) RegisterProver() (*types
 	return _ProvingNetwork.Contract.RegisterProver(&_ProvingNetwork.TransactOpts)
 }
 
-// SubmitSignedProof is a paid mutator transaction binding the contract method 0x52668861.
+// ReportFailedRequest is a paid mutator transaction binding the contract method 0x7e53b9f1.
+//
+// Solidity: function reportFailedRequest(string requestId, uint256 reward, address[] failedProvers, bytes32[] proofHashes, uint8[] signaturesCounts, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
+func (_ProvingNetwork *ProvingNetworkTransactor) ReportFailedRequest(opts *bind.TransactOpts, requestId string, reward *big.Int, failedProvers []common.Address, proofHashes [][32]byte, signaturesCounts []uint8, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.contract.Transact(opts, "reportFailedRequest", requestId, reward, failedProvers, proofHashes, signaturesCounts, rs, ss, vs)
+}
+
+// ReportFailedRequest is a paid mutator transaction binding the contract method 0x7e53b9f1.
+//
+// Solidity: function reportFailedRequest(string requestId, uint256 reward, address[] failedProvers, bytes32[] proofHashes, uint8[] signaturesCounts, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
+func (_ProvingNetwork *ProvingNetworkSession) ReportFailedRequest(requestId string, reward *big.Int, failedProvers []common.Address, proofHashes [][32]byte, signaturesCounts []uint8, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.Contract.ReportFailedRequest(&_ProvingNetwork.TransactOpts, requestId, reward, failedProvers, proofHashes, signaturesCounts, rs, ss, vs)
+}
+
+// ReportFailedRequest is a paid mutator transaction binding the contract method 0x7e53b9f1.
+//
+// Solidity: function reportFailedRequest(string requestId, uint256 reward, address[] failedProvers, bytes32[] proofHashes, uint8[] signaturesCounts, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
+func (_ProvingNetwork *ProvingNetworkTransactorSession) ReportFailedRequest(requestId string, reward *big.Int, failedProvers []common.Address, proofHashes [][32]byte, signaturesCounts []uint8, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.Contract.ReportFailedRequest(&_ProvingNetwork.TransactOpts, requestId, reward, failedProvers, proofHashes, signaturesCounts, rs, ss, vs)
+}
+
+// SlashEquivocation is a paid mutator transaction binding the contract method 0x42eba9b9.
+//
+// Solidity: function slashEquivocation(string requestId, address prover, bytes32 proofHash, bytes32[2] rs, bytes32[2] ss, uint8[2] vs) returns()
+func (_ProvingNetwork *ProvingNetworkTransactor) SlashEquivocation(opts *bind.TransactOpts, requestId string, prover common.Address, proofHash [32]byte, rs [2][32]byte, ss [2][32]byte, vs [2]uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.contract.Transact(opts, "slashEquivocation", requestId, prover, proofHash, rs, ss, vs)
+}
+
+// SlashEquivocation is a paid mutator transaction binding the contract method 0x42eba9b9.
+//
+// Solidity: function slashEquivocation(string requestId, address prover, bytes32 proofHash, bytes32[2] rs, bytes32[2] ss, uint8[2] vs) returns()
+func (_ProvingNetwork *ProvingNetworkSession) SlashEquivocation(requestId string, prover common.Address, proofHash [32]byte, rs [2][32]byte, ss [2][32]byte, vs [2]uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.Contract.SlashEquivocation(&_ProvingNetwork.TransactOpts, requestId, prover, proofHash, rs, ss, vs)
+}
+
+// SlashEquivocation is a paid mutator transaction binding the contract method 0x42eba9b9.
+//
+// Solidity: function slashEquivocation(string requestId, address prover, bytes32 proofHash, bytes32[2] rs, bytes32[2] ss, uint8[2] vs) returns()
+func (_ProvingNetwork *ProvingNetworkTransactorSession) SlashEquivocation(requestId string, prover common.Address, proofHash [32]byte, rs [2][32]byte, ss [2][32]byte, vs [2]uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.Contract.SlashEquivocation(&_ProvingNetwork.TransactOpts, requestId, prover, proofHash, rs, ss, vs)
+}
+
+// SlashInvalidProof is a paid mutator transaction binding the contract method 0x137f3243.
 //
-// Solidity: function submitSignedProof(string requestId, uint256 reward, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
-func (_ProvingNetwork *ProvingNetworkTransactor) SubmitSignedProof(opts *bind.TransactOpts, requestId string, reward *big.Int, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
-	return _ProvingNetwork.contract.Transact(opts, "submitSignedProof", requestId, reward, rs, ss, vs)
+// Solidity: function slashInvalidProof(string requestId, address prover, bytes32 proofHash, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
+func (_ProvingNetwork *ProvingNetworkTransactor) SlashInvalidProof(opts *bind.TransactOpts, requestId string, prover common.Address, proofHash [32]byte, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.contract.Transact(opts, "slashInvalidProof", requestId, prover, proofHash, rs, ss, vs)
 }
 
-// SubmitSignedProof is a paid mutator transaction binding the contract method 0x52668861.
+// SlashInvalidProof is a paid mutator transaction binding the contract method 0x137f3243.
 //
-// Solidity: function submitSignedProof(string requestId, uint256 reward, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
-func (_ProvingNetwork *ProvingNetworkSession) SubmitSignedProof(requestId string, reward *big.Int, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
-	return _ProvingNetwork.Contract.SubmitSignedProof(&_ProvingNetwork.TransactOpts, requestId, reward, rs, ss, vs)
+// Solidity: function slashInvalidProof(string requestId, address prover, bytes32 proofHash, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
+func (_ProvingNetwork *ProvingNetworkSession) SlashInvalidProof(requestId string, prover common.Address, proofHash [32]byte, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.Contract.SlashInvalidProof(&_ProvingNetwork.TransactOpts, requestId, prover, proofHash, rs, ss, vs)
 }
 
-// SubmitSignedProof is a paid mutator transaction binding the contract method 0x52668861.
+// SlashInvalidProof is a paid mutator transaction binding the contract method 0x137f3243.
 //
-// Solidity: function submitSignedProof(string requestId, uint256 reward, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
-func (_ProvingNetwork *ProvingNetworkTransactorSession) SubmitSignedProof(requestId string, reward *big.Int, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
-	return _ProvingNetwork.Contract.SubmitSignedProof(&_ProvingNetwork.TransactOpts, requestId, reward, rs, ss, vs)
+// Solidity: function slashInvalidProof(string requestId, address prover, bytes32 proofHash, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
+func (_ProvingNetwork *ProvingNetworkTransactorSession) SlashInvalidProof(requestId string, prover common.Address, proofHash [32]byte, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.Contract.SlashInvalidProof(&_ProvingNetwork.TransactOpts, requestId, prover, proofHash, rs, ss, vs)
+}
+
+// SubmitSignedProof is a paid mutator transaction binding the contract method 0x0579dcd4.
+//
+// Solidity: function submitSignedProof(string requestId, uint256 reward, bytes32 proofHash, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
+func (_ProvingNetwork *ProvingNetworkTransactor) SubmitSignedProof(opts *bind.TransactOpts, requestId string, reward *big.Int, proofHash [32]byte, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.contract.Transact(opts, "submitSignedProof", requestId, reward, proofHash, rs, ss, vs)
+}
+
+// SubmitSignedProof is a paid mutator transaction binding the contract method 0x0579dcd4.
+//
+// Solidity: function submitSignedProof(string requestId, uint256 reward, bytes32 proofHash, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
+func (_ProvingNetwork *ProvingNetworkSession) SubmitSignedProof(requestId string, reward *big.Int, proofHash [32]byte, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.Contract.SubmitSignedProof(&_ProvingNetwork.TransactOpts, requestId, reward, proofHash, rs, ss, vs)
+}
+
+// SubmitSignedProof is a paid mutator transaction binding the contract method 0x0579dcd4.
+//
+// Solidity: function submitSignedProof(string requestId, uint256 reward, bytes32 proofHash, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
+func (_ProvingNetwork *ProvingNetworkTransactorSession) SubmitSignedProof(requestId string, reward *big.Int, proofHash [32]byte, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.Contract.SubmitSignedProof(&_ProvingNetwork.TransactOpts, requestId, reward, proofHash, rs, ss, vs)
+}
+
+// SubmitSignedProofs is a paid mutator transaction binding the contract method 0x3aad0a2e.
+//
+// Solidity: function submitSignedProofs(string[] requestIds, uint256[] rewards, bytes32[] proofHashes, uint8[] signaturesCounts, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
+func (_ProvingNetwork *ProvingNetworkTransactor) SubmitSignedProofs(opts *bind.TransactOpts, requestIds []string, rewards []*big.Int, proofHashes [][32]byte, signaturesCounts []uint8, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.contract.Transact(opts, "submitSignedProofs", requestIds, rewards, proofHashes, signaturesCounts, rs, ss, vs)
+}
+
+// SubmitSignedProofs is a paid mutator transaction binding the contract method 0x3aad0a2e.
+//
+// Solidity: function submitSignedProofs(string[] requestIds, uint256[] rewards, bytes32[] proofHashes, uint8[] signaturesCounts, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
+func (_ProvingNetwork *ProvingNetworkSession) SubmitSignedProofs(requestIds []string, rewards []*big.Int, proofHashes [][32]byte, signaturesCounts []uint8, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.Contract.SubmitSignedProofs(&_ProvingNetwork.TransactOpts, requestIds, rewards, proofHashes, signaturesCounts, rs, ss, vs)
+}
+
+// SubmitSignedProofs is a paid mutator transaction binding the contract method 0x3aad0a2e.
+//
+// Solidity: function submitSignedProofs(string[] requestIds, uint256[] rewards, bytes32[] proofHashes, uint8[] signaturesCounts, bytes32[] rs, bytes32[] ss, uint8[] vs) returns()
+func (_ProvingNetwork *ProvingNetworkTransactorSession) SubmitSignedProofs(requestIds []string, rewards []*big.Int, proofHashes [][32]byte, signaturesCounts []uint8, rs [][32]byte, ss [][32]byte, vs []uint8) (*types.Transaction, error) {
+	return _ProvingNetwork.Contract.SubmitSignedProofs(&_ProvingNetwork.TransactOpts, requestIds, rewards, proofHashes, signaturesCounts, rs, ss, vs)
 }
 
 // WithdrawConsumer is a paid mutator transaction binding the contract method 0x13799aa9.
@@ -809,6 +1141,144 @@ func (_ProvingNetwork *ProvingNetworkFilterer) ParseConsumerUpdate(log types.Log
 	return event, nil
 }
 
+// ProvingNetworkProverSlashedIterator is returned from FilterProverSlashed and is used to iterate over the raw logs and unpacked data for ProverSlashed events raised by the ProvingNetwork contract.
+type ProvingNetworkProverSlashedIterator struct {
+	Event *ProvingNetworkProverSlashed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProvingNetworkProverSlashedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProvingNetworkProverSlashed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProvingNetworkProverSlashed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProvingNetworkProverSlashedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProvingNetworkProverSlashedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProvingNetworkProverSlashed represents a ProverSlashed event raised by the ProvingNetwork contract.
+type ProvingNetworkProverSlashed struct {
+	Prover    common.Address
+	RequestId string
+	Reason    uint8
+	Amount    *big.Int
+	Reporter  common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterProverSlashed is a free log retrieval operation binding the contract event 0x362832ea4b95229b7049a6063a80720f74f9fae2da5a81f5635cc7222c5debbc.
+//
+// Solidity: event ProverSlashed(address prover, string requestId, uint8 reason, uint256 amount, address reporter)
+func (_ProvingNetwork *ProvingNetworkFilterer) FilterProverSlashed(opts *bind.FilterOpts) (*ProvingNetworkProverSlashedIterator, error) {
+
+	logs, sub, err := _ProvingNetwork.contract.FilterLogs(opts, "ProverSlashed")
+	if err != nil {
+		return nil, err
+	}
+	return &ProvingNetworkProverSlashedIterator{contract: _ProvingNetwork.contract, event: "ProverSlashed", logs: logs, sub: sub}, nil
+}
+
+// WatchProverSlashed is a free log subscription operation binding the contract event 0x362832ea4b95229b7049a6063a80720f74f9fae2da5a81f5635cc7222c5debbc.
+//
+// Solidity: event ProverSlashed(address prover, string requestId, uint8 reason, uint256 amount, address reporter)
+func (_ProvingNetwork *ProvingNetworkFilterer) WatchProverSlashed(opts *bind.WatchOpts, sink chan<- *ProvingNetworkProverSlashed) (event.Subscription, error) {
+
+	logs, sub, err := _ProvingNetwork.contract.WatchLogs(opts, "ProverSlashed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProvingNetworkProverSlashed)
+				if err := _ProvingNetwork.contract.UnpackLog(event, "ProverSlashed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProverSlashed is a log parse operation binding the contract event 0x362832ea4b95229b7049a6063a80720f74f9fae2da5a81f5635cc7222c5debbc.
+//
+// Solidity: event ProverSlashed(address prover, string requestId, uint8 reason, uint256 amount, address reporter)
+func (_ProvingNetwork *ProvingNetworkFilterer) ParseProverSlashed(log types.Log) (*ProvingNetworkProverSlashed, error) {
+	event := new(ProvingNetworkProverSlashed)
+	if err := _ProvingNetwork.contract.UnpackLog(event, "ProverSlashed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // ProvingNetworkProverUpdateIterator is returned from FilterProverUpdate and is used to iterate over the raw logs and unpacked data for ProverUpdate events raised by the ProvingNetwork contract.
 type ProvingNetworkProverUpdateIterator struct {
 	Event *ProvingNetworkProverUpdate // Event containing the contract specifics and raw log
@@ -943,3 +1413,275 @@ func (_ProvingNetwork *ProvingNetworkFilterer) ParseProverUpdate(log types.Log)
 	event.Raw = log
 	return event, nil
 }
+
+// ProvingNetworkRequestFailedIterator is returned from FilterRequestFailed and is used to iterate over the raw logs and unpacked data for RequestFailed events raised by the ProvingNetwork contract.
+type ProvingNetworkRequestFailedIterator struct {
+	Event *ProvingNetworkRequestFailed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProvingNetworkRequestFailedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProvingNetworkRequestFailed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProvingNetworkRequestFailed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProvingNetworkRequestFailedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProvingNetworkRequestFailedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProvingNetworkRequestFailed represents a RequestFailed event raised by the ProvingNetwork contract.
+type ProvingNetworkRequestFailed struct {
+	RequestId string
+	Consumer  common.Address
+	Provers   []common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterRequestFailed is a free log retrieval operation binding the contract event 0x20960f23a0572bc816864d02ff100ccdc58fa7834381eca3e64f629e561df541.
+//
+// Solidity: event RequestFailed(string requestId, address consumer, address[] provers)
+func (_ProvingNetwork *ProvingNetworkFilterer) FilterRequestFailed(opts *bind.FilterOpts) (*ProvingNetworkRequestFailedIterator, error) {
+
+	logs, sub, err := _ProvingNetwork.contract.FilterLogs(opts, "RequestFailed")
+	if err != nil {
+		return nil, err
+	}
+	return &ProvingNetworkRequestFailedIterator{contract: _ProvingNetwork.contract, event: "RequestFailed", logs: logs, sub: sub}, nil
+}
+
+// WatchRequestFailed is a free log subscription operation binding the contract event 0x20960f23a0572bc816864d02ff100ccdc58fa7834381eca3e64f629e561df541.
+//
+// Solidity: event RequestFailed(string requestId, address consumer, address[] provers)
+func (_ProvingNetwork *ProvingNetworkFilterer) WatchRequestFailed(opts *bind.WatchOpts, sink chan<- *ProvingNetworkRequestFailed) (event.Subscription, error) {
+
+	logs, sub, err := _ProvingNetwork.contract.WatchLogs(opts, "RequestFailed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProvingNetworkRequestFailed)
+				if err := _ProvingNetwork.contract.UnpackLog(event, "RequestFailed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRequestFailed is a log parse operation binding the contract event 0x20960f23a0572bc816864d02ff100ccdc58fa7834381eca3e64f629e561df541.
+//
+// Solidity: event RequestFailed(string requestId, address consumer, address[] provers)
+func (_ProvingNetwork *ProvingNetworkFilterer) ParseRequestFailed(log types.Log) (*ProvingNetworkRequestFailed, error) {
+	event := new(ProvingNetworkRequestFailed)
+	if err := _ProvingNetwork.contract.UnpackLog(event, "RequestFailed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ProvingNetworkRewardClaimedIterator is returned from FilterRewardClaimed and is used to iterate over the raw logs and unpacked data for RewardClaimed events raised by the ProvingNetwork contract.
+type ProvingNetworkRewardClaimedIterator struct {
+	Event *ProvingNetworkRewardClaimed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProvingNetworkRewardClaimedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProvingNetworkRewardClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProvingNetworkRewardClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProvingNetworkRewardClaimedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProvingNetworkRewardClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProvingNetworkRewardClaimed represents a RewardClaimed event raised by the ProvingNetwork contract.
+type ProvingNetworkRewardClaimed struct {
+	RequestId string
+	Prover    common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterRewardClaimed is a free log retrieval operation binding the contract event 0x6e5596fe4f25c687a26b2421b8816d678eb3615ab1a5a91fb9cb43ed8b92751d.
+//
+// Solidity: event RewardClaimed(string requestId, address prover, uint256 amount)
+func (_ProvingNetwork *ProvingNetworkFilterer) FilterRewardClaimed(opts *bind.FilterOpts) (*ProvingNetworkRewardClaimedIterator, error) {
+
+	logs, sub, err := _ProvingNetwork.contract.FilterLogs(opts, "RewardClaimed")
+	if err != nil {
+		return nil, err
+	}
+	return &ProvingNetworkRewardClaimedIterator{contract: _ProvingNetwork.contract, event: "RewardClaimed", logs: logs, sub: sub}, nil
+}
+
+// WatchRewardClaimed is a free log subscription operation binding the contract event 0x6e5596fe4f25c687a26b2421b8816d678eb3615ab1a5a91fb9cb43ed8b92751d.
+//
+// Solidity: event RewardClaimed(string requestId, address prover, uint256 amount)
+func (_ProvingNetwork *ProvingNetworkFilterer) WatchRewardClaimed(opts *bind.WatchOpts, sink chan<- *ProvingNetworkRewardClaimed) (event.Subscription, error) {
+
+	logs, sub, err := _ProvingNetwork.contract.WatchLogs(opts, "RewardClaimed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProvingNetworkRewardClaimed)
+				if err := _ProvingNetwork.contract.UnpackLog(event, "RewardClaimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRewardClaimed is a log parse operation binding the contract event 0x6e5596fe4f25c687a26b2421b8816d678eb3615ab1a5a91fb9cb43ed8b92751d.
+//
+// Solidity: event RewardClaimed(string requestId, address prover, uint256 amount)
+func (_ProvingNetwork *ProvingNetworkFilterer) ParseRewardClaimed(log types.Log) (*ProvingNetworkRewardClaimed, error) {
+	event := new(ProvingNetworkRewardClaimed)
+	if err := _ProvingNetwork.contract.UnpackLog(event, "RewardClaimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -4,18 +4,88 @@ import (
 	"github.com/caarlos0/env"
 	"github.com/libp2p/go-libp2p/core"
 	"github.com/pkg/errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	VoteWeightingStake = "stake"
+	VoteWeightingCount = "count"
+
+	RandomnessBlockHash    = "blockhash"
+	RandomnessCommitReveal = "commit-reveal"
+
+	SelectionPolicyCapacity = "capacity"
+	SelectionPolicyUniform  = "uniform"
 )
 
 type Config struct {
-	EthereumAPI     string          `env:"ETHEREUM_API,required"`
-	ProtocolID      core.ProtocolID `env:"PROTOCOL_ID" envDefault:"/p2p/gpn-node-te/1.0.0"`
-	SyncProtocolID  core.ProtocolID `env:"SYNC_PROTOCOL_ID" envDefault:"/p2p/gpn-sync/1.0.0"`
-	Namespace       string          `env:"NAMESPACE" envDefault:"mpc-pubsub"`
-	PrivateKeyPath  string          `env:"PRIVATE_KEY_PATH" envDefault:"priv.key"`
-	ContractAddress string          `env:"CONTRACT_ADDRESS" envDefault:"0x5510E82f2A7f0B1397Ef60FE1751DCB722C66ED9"`
-	Port            string          `env:"PORT" envDefault:"0"`
-	Consumers       []string        `env:"CONSUMERS" envDefault:"matterlabs/prover,scroll-tech/scroll-prover"`
-	Mode            string          `env:"MODE" envDefault:"production"`
+	EthereumAPI          string          `env:"ETHEREUM_API,required"`
+	ProtocolID           core.ProtocolID `env:"PROTOCOL_ID" envDefault:"/p2p/gpn-node-te/1.0.0"`
+	SyncProtocolID       core.ProtocolID `env:"SYNC_PROTOCOL_ID" envDefault:"/p2p/gpn-sync/1.0.0"`
+	LookupProtocolID     core.ProtocolID `env:"LOOKUP_PROTOCOL_ID" envDefault:"/p2p/gpn-proof-lookup/1.0.0"`
+	Namespace            string          `env:"NAMESPACE" envDefault:"mpc-pubsub"`
+	PrivateKeyPath       string          `env:"PRIVATE_KEY_PATH" envDefault:"priv.key"`
+	ContractAddress      string          `env:"CONTRACT_ADDRESS" envDefault:"0x5510E82f2A7f0B1397Ef60FE1751DCB722C66ED9"`
+	Port                 string          `env:"PORT" envDefault:"0"`
+	Consumers            []string        `env:"CONSUMERS" envDefault:"matterlabs/prover,scroll-tech/scroll-prover"`
+	Mode                 string          `env:"MODE" envDefault:"production"`
+	StorageBackend       string          `env:"STORAGE_BACKEND" envDefault:"leveldb"`
+	StoragePath          string          `env:"STORAGE_PATH" envDefault:"data"`
+	MetricsPort          int             `env:"METRICS_PORT" envDefault:"9090"`
+	TracingExporter      string          `env:"TRACING_EXPORTER" envDefault:"none"`
+	OTLPEndpoint         string          `env:"OTLP_ENDPOINT" envDefault:"localhost:4317"`
+	TracingFile          string          `env:"TRACING_FILE" envDefault:"traces.json"`
+	HeartbeatInterval    time.Duration   `env:"HEARTBEAT_INTERVAL" envDefault:"5s"`
+	MissedHeartbeats     int             `env:"MISSED_HEARTBEATS" envDefault:"3"`
+	EvictAfterHeartbeats int             `env:"EVICT_AFTER_HEARTBEATS" envDefault:"60"`
+	ProvingDeadline      time.Duration   `env:"PROVING_DEADLINE" envDefault:"10m"`
+	ProvingDeadlines     []string        `env:"PROVING_DEADLINES"` // per-consumer overrides, image=duration
+	SelectionDeadline    time.Duration   `env:"SELECTION_DEADLINE" envDefault:"1m"`
+	ReportFailures       bool            `env:"REPORT_FAILURES" envDefault:"false"`
+	FailureReportDelay   time.Duration   `env:"FAILURE_REPORT_DELAY" envDefault:"1m"` // the wait of every next reporter in case the previous ones didn't report
+	SubmitEvidence       bool            `env:"SUBMIT_EVIDENCE" envDefault:"false"`
+	VoteWeighting        string          `env:"VOTE_WEIGHTING" envDefault:"stake"` // stake or count
+	StakeWeightCap       string          `env:"STAKE_WEIGHT_CAP" envDefault:"2"`   // in ether, empty means no cap
+	StakeRefreshInterval time.Duration   `env:"STAKE_REFRESH_INTERVAL" envDefault:"1m"`
+	SelectionVotingTime  time.Duration   `env:"SELECTION_VOTING_TIME" envDefault:"4s"`
+	ValidationVotingTime time.Duration   `env:"VALIDATION_VOTING_TIME" envDefault:"30s"`
+	VotingQuorum         float64         `env:"VOTING_QUORUM" envDefault:"0.5"`
+	VotingThreshold      float64         `env:"VOTING_THRESHOLD" envDefault:"0.5"`
+	ConsumerVoting       []string        `env:"CONSUMER_VOTING"`                          // per-consumer overrides, image=quorum/threshold
+	RandomnessSource     string          `env:"RANDOMNESS_SOURCE" envDefault:"blockhash"` // blockhash or commit-reveal
+	RandomnessBlockDelay uint64          `env:"RANDOMNESS_BLOCK_DELAY" envDefault:"1"`
+	CommitRevealWindow   time.Duration   `env:"COMMIT_REVEAL_WINDOW" envDefault:"3s"`
+	SelectionPolicy      string          `env:"SELECTION_POLICY" envDefault:"capacity"` // capacity or uniform
+	ProvingSlots         int             `env:"PROVING_SLOTS" envDefault:"1"`
+	ConsumerSlots        []string        `env:"CONSUMER_SLOTS"` // per-consumer limits, image=slots
+	JobQueueSize         int             `env:"JOB_QUEUE_SIZE" envDefault:"16"`
+	NodeCores            int             `env:"NODE_CORES"`                              // detected if not set
+	NodeMemoryMB         uint64          `env:"NODE_MEMORY_MB"`                          // detected if not set
+	MaxFeePerGas         string          `env:"MAX_FEE_PER_GAS" envDefault:"200"`        // in gwei
+	MaxPriorityFeePerGas string          `env:"MAX_PRIORITY_FEE_PER_GAS" envDefault:"2"` // in gwei
+	SubmitBatchSize      int             `env:"SUBMIT_BATCH_SIZE" envDefault:"16"`       // 1 submits every request on its own
+	SubmitBatchWindow    time.Duration   `env:"SUBMIT_BATCH_WINDOW" envDefault:"10s"`
+	AutoClaim            bool            `env:"AUTO_CLAIM" envDefault:"true"`
+	PayoutCheckInterval  time.Duration   `env:"PAYOUT_CHECK_INTERVAL" envDefault:"1m"`
+	OutboxPollInterval   time.Duration   `env:"OUTBOX_POLL_INTERVAL" envDefault:"5s"`
+	OutboxReplaceAfter   time.Duration   `env:"OUTBOX_REPLACE_AFTER" envDefault:"3m"` // a transaction pending longer is replaced with bumped fees
+	OutboxMaxAttempts    int             `env:"OUTBOX_MAX_ATTEMPTS" envDefault:"10"`  // the calls that keep reverting are given up after
+
+	provingDeadlines map[string]time.Duration
+	stakeWeightCap   *big.Int
+	consumerVoting   map[string]VotingParams
+	consumerSlots    map[string]int
+	maxFeePerGas     *big.Int
+	maxPriorityFee   *big.Int
+}
+
+// VotingParams are the shares of the eligible weight that has to vote and of the voted weight the winner needs
+type VotingParams struct {
+	Quorum    float64
+	Threshold float64
 }
 
 func NewConfig() (*Config, error) {
@@ -28,9 +98,175 @@ func NewConfig() (*Config, error) {
 		return nil, errors.Wrap(err, "error on validating config")
 	}
 
+	deadlines, err := parseProvingDeadlines(conf.ProvingDeadlines)
+	if err != nil {
+		return nil, errors.Wrap(err, "error on parsing proving deadlines")
+	}
+	conf.provingDeadlines = deadlines
+
+	votingParams, err := parseConsumerVoting(conf.ConsumerVoting)
+	if err != nil {
+		return nil, errors.Wrap(err, "error on parsing consumer voting params")
+	}
+	conf.consumerVoting = votingParams
+
+	slots, err := parseConsumerSlots(conf.ConsumerSlots)
+	if err != nil {
+		return nil, errors.Wrap(err, "error on parsing consumer slots")
+	}
+	conf.consumerSlots = slots
+
+	if conf.StakeWeightCap != "" {
+		stakeCap, err := ParseEther(conf.StakeWeightCap)
+		if err != nil {
+			return nil, errors.Wrap(err, "error on parsing stake weight cap")
+		}
+		conf.stakeWeightCap = stakeCap
+	}
+
+	if conf.maxFeePerGas, err = ParseGwei(conf.MaxFeePerGas); err != nil {
+		return nil, errors.Wrap(err, "error on parsing max fee per gas")
+	}
+
+	if conf.maxPriorityFee, err = ParseGwei(conf.MaxPriorityFeePerGas); err != nil {
+		return nil, errors.Wrap(err, "error on parsing max priority fee per gas")
+	}
+
+	if conf.maxPriorityFee.Cmp(conf.maxFeePerGas) > 0 {
+		return nil, errors.New("max priority fee per gas can't exceed max fee per gas")
+	}
+
 	return conf, nil
 }
 
+// ProvingDeadlineFor returns how long the selected prover has to publish the proof for the consumer
+func (c *Config) ProvingDeadlineFor(consumerImage string) time.Duration {
+	if d, ok := c.provingDeadlines[consumerImage]; ok {
+		return d
+	}
+
+	return c.ProvingDeadline
+}
+
+// VotingParamsFor returns the quorum and the threshold of the votings on the consumer's requests
+func (c *Config) VotingParamsFor(consumerImage string) VotingParams {
+	if p, ok := c.consumerVoting[consumerImage]; ok {
+		return p
+	}
+
+	return VotingParams{
+		Quorum:    c.VotingQuorum,
+		Threshold: c.VotingThreshold,
+	}
+}
+
+// ConsumerSlotsFor returns how many proofs of the consumer the node computes concurrently
+func (c *Config) ConsumerSlotsFor(consumerImage string) int {
+	if n, ok := c.consumerSlots[consumerImage]; ok {
+		return min(n, c.ProvingSlots)
+	}
+
+	return c.ProvingSlots
+}
+
+// MaxFeePerGasWei returns the cap of the EIP-1559 fee per gas in wei
+func (c *Config) MaxFeePerGasWei() *big.Int {
+	return c.maxFeePerGas
+}
+
+// MaxPriorityFeePerGasWei returns the cap of the EIP-1559 tip per gas in wei
+func (c *Config) MaxPriorityFeePerGasWei() *big.Int {
+	return c.maxPriorityFee
+}
+
+// StakeWeightCapWei returns the maximal weight of a single voter in wei, nil means the weight isn't capped
+func (c *Config) StakeWeightCapWei() *big.Int {
+	return c.stakeWeightCap
+}
+
+func parseProvingDeadlines(values []string) (map[string]time.Duration, error) {
+	deadlines := make(map[string]time.Duration, len(values))
+	for _, v := range values {
+		image, duration, ok := strings.Cut(v, "=")
+		if !ok || image == "" {
+			return nil, errors.Errorf("expected image=duration, got %s", v)
+		}
+
+		d, err := time.ParseDuration(duration)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid duration for %s", image)
+		}
+
+		if d <= 0 {
+			return nil, errors.Errorf("proving deadline for %s must be positive", image)
+		}
+
+		deadlines[image] = d
+	}
+
+	return deadlines, nil
+}
+
+func parseConsumerVoting(values []string) (map[string]VotingParams, error) {
+	params := make(map[string]VotingParams, len(values))
+	for _, v := range values {
+		image, shares, ok := strings.Cut(v, "=")
+		if !ok || image == "" {
+			return nil, errors.Errorf("expected image=quorum/threshold, got %s", v)
+		}
+
+		quorum, threshold, ok := strings.Cut(shares, "/")
+		if !ok {
+			return nil, errors.Errorf("expected quorum/threshold for %s, got %s", image, shares)
+		}
+
+		q, err := strconv.ParseFloat(quorum, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid quorum for %s", image)
+		}
+
+		t, err := strconv.ParseFloat(threshold, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid threshold for %s", image)
+		}
+
+		if !isShare(q) || !isShare(t) {
+			return nil, errors.Errorf("quorum and threshold for %s must be in (0, 1]", image)
+		}
+
+		params[image] = VotingParams{Quorum: q, Threshold: t}
+	}
+
+	return params, nil
+}
+
+func parseConsumerSlots(values []string) (map[string]int, error) {
+	slots := make(map[string]int, len(values))
+	for _, v := range values {
+		image, n, ok := strings.Cut(v, "=")
+		if !ok || image == "" {
+			return nil, errors.Errorf("expected image=slots, got %s", v)
+		}
+
+		limit, err := strconv.Atoi(n)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid slots for %s", image)
+		}
+
+		if limit <= 0 {
+			return nil, errors.Errorf("slots for %s must be positive", image)
+		}
+
+		slots[image] = limit
+	}
+
+	return slots, nil
+}
+
+func isShare(v float64) bool {
+	return v > 0 && v <= 1
+}
+
 func validateConfig(cfg Config) error {
 	if cfg.ProtocolID == "" {
 		return errors.New("protocol ID is required")
@@ -48,5 +284,89 @@ func validateConfig(cfg Config) error {
 		return errors.New("port is required")
 	}
 
+	if cfg.StorageBackend == "" {
+		return errors.New("storage backend is required")
+	}
+
+	if cfg.StoragePath == "" {
+		return errors.New("storage path is required")
+	}
+
+	if cfg.HeartbeatInterval <= 0 {
+		return errors.New("heartbeat interval must be positive")
+	}
+
+	if cfg.MissedHeartbeats <= 0 {
+		return errors.New("missed heartbeats must be positive")
+	}
+
+	if cfg.EvictAfterHeartbeats <= cfg.MissedHeartbeats {
+		return errors.New("evict after heartbeats must be greater than missed heartbeats")
+	}
+
+	if cfg.ProvingDeadline <= 0 {
+		return errors.New("proving deadline must be positive")
+	}
+
+	if cfg.SelectionDeadline <= cfg.SelectionVotingTime {
+		return errors.New("selection deadline must be greater than selection voting time")
+	}
+
+	if cfg.VoteWeighting != VoteWeightingStake && cfg.VoteWeighting != VoteWeightingCount {
+		return errors.Errorf("vote weighting must be %s or %s", VoteWeightingStake, VoteWeightingCount)
+	}
+
+	if cfg.SelectionVotingTime <= 0 || cfg.ValidationVotingTime <= 0 {
+		return errors.New("voting time must be positive")
+	}
+
+	if !isShare(cfg.VotingQuorum) || !isShare(cfg.VotingThreshold) {
+		return errors.New("voting quorum and threshold must be in (0, 1]")
+	}
+
+	if cfg.RandomnessSource != RandomnessBlockHash && cfg.RandomnessSource != RandomnessCommitReveal {
+		return errors.Errorf("randomness source must be %s or %s", RandomnessBlockHash, RandomnessCommitReveal)
+	}
+
+	if cfg.SelectionPolicy != SelectionPolicyCapacity && cfg.SelectionPolicy != SelectionPolicyUniform {
+		return errors.Errorf("selection policy must be %s or %s", SelectionPolicyCapacity, SelectionPolicyUniform)
+	}
+
+	if cfg.ProvingSlots <= 0 {
+		return errors.New("proving slots must be positive")
+	}
+
+	if cfg.JobQueueSize < 0 {
+		return errors.New("job queue size can't be negative")
+	}
+
+	if cfg.SubmitBatchSize <= 0 || cfg.SubmitBatchWindow < 0 {
+		return errors.New("submit batch size must be positive and the window can't be negative")
+	}
+
+	if cfg.PayoutCheckInterval <= 0 {
+		return errors.New("payout check interval must be positive")
+	}
+
+	if cfg.OutboxPollInterval <= 0 || cfg.OutboxReplaceAfter <= 0 {
+		return errors.New("outbox intervals must be positive")
+	}
+
+	if cfg.OutboxMaxAttempts <= 0 {
+		return errors.New("outbox max attempts must be positive")
+	}
+
+	if cfg.CommitRevealWindow <= 0 {
+		return errors.New("commit-reveal window must be positive")
+	}
+
+	if cfg.StakeRefreshInterval <= 0 {
+		return errors.New("stake refresh interval must be positive")
+	}
+
+	if cfg.FailureReportDelay <= 0 {
+		return errors.New("failure report delay must be positive")
+	}
+
 	return nil
 }
@@ -5,14 +5,6 @@ import (
 	"encoding/gob"
 )
 
-func InitGobModels() {
-	gob.Register(ProverSelectionPayload{})
-	gob.Register(ValidationPayload{})
-	gob.Register(ProvingRequestMessage{})
-	gob.Register(ZKProof{})
-	gob.Register(RequestExtension{})
-}
-
 func GobEncodeMessage(msg any) ([]byte, error) {
 	var buf bytes.Buffer
 	enc := gob.NewEncoder(&buf)
@@ -12,8 +12,9 @@ type ComputeProofRequest struct {
 	ID              string
 	ConsumerImage   string
 	ConsumerAddress string
-	Signature       []byte // signature has to be done of the requestID
+	Signature       []byte // signature of keccak256(abi.encodePacked(requestID, reward))
 	Data            []byte
+	Reward          *big.Int // in wei
 }
 
 type NodeData struct {
@@ -22,6 +23,38 @@ type NodeData struct {
 	CurrentRequestID *RequestID
 	Commitments      []string
 	AvailableSince   int64
+	LastHeartbeat    int64
+	Reachable        bool // false after the configured number of missed heartbeats
+	Capacity         Capacity
+}
+
+// Capacity is advertised by the nodes with every status message
+type Capacity struct {
+	Cores        int
+	MemoryMB     uint64
+	Slots        int              // number of the proofs the node can compute concurrently
+	ActiveJobs   int              // number of the proofs the node is computing now
+	FreeSlots    map[string]int   // number of the proofs the node can take now per consumer image
+	ProvingTimes map[string]int64 // moving average of the proving time per consumer image, in milliseconds
+	QueuedJobs   int              // number of the proofs waiting for a free slot
+	QueueSize    int
+}
+
+// QueueFree returns how many more proofs the node can queue
+func (c Capacity) QueueFree() int {
+	return max(c.QueueSize-c.QueuedJobs, 0)
+}
+
+// ProvingJob is a proof this node was selected to compute, it's kept in the storage until the proof is published
+type ProvingJob struct {
+	Request    ProvingRequestMessage
+	Attempt    int
+	EnqueuedAt int64
+}
+
+// FreeSlotsFor returns how many more proofs of the consumer the node can take
+func (c Capacity) FreeSlotsFor(consumerImage string) int {
+	return c.FreeSlots[consumerImage]
 }
 
 type ZKProof struct {
@@ -49,9 +82,269 @@ type ValidationSignature struct {
 	Signature []byte
 }
 
+// RequestPhase is a stage of the request lifecycle, see logic.phaseTransitions for the allowed transitions
+type RequestPhase int
+
+const (
+	PhaseUnknown RequestPhase = iota
+	PhaseSelectingProver
+	PhaseProving
+	PhaseValidating
+	PhaseSubmitted
+	PhaseFailed
+	PhaseFinalized // the proof is accepted by the network, the prover is submitting it
+)
+
+func (p RequestPhase) String() string {
+	return [...]string{"PhaseUnknown", "PhaseSelectingProver", "PhaseProving", "PhaseValidating", "PhaseSubmitted", "PhaseFailed", "PhaseFinalized"}[p]
+}
+
 type RequestExtension struct {
 	ProvingRequestMessage
+	Phase                RequestPhase
 	ProvingPeers         []peer.ID
 	Proofs               map[peer.ID]ZKProof
 	ValidationSignatures map[peer.ID]map[peer.ID][]byte // proving peer ID -> validation peer ID -> validation signature
+	ValidationVotes      map[peer.ID]map[peer.ID]bool   // proving peer ID -> validation peer ID -> is proof valid
+	SubmissionTxHash     string
+	ProvingStartedAt     int64     // when the current prover was selected, the proving deadline counts from it
+	TimedOutPeers        []peer.ID // provers that didn't publish the proof before the deadline
+	SelectionStartedAt   int64     // when the current prover selection started, the selection deadline counts from it
+}
+
+// FinalizedProof is a proof accepted by the network, together with the positive validation signatures proving it
+type FinalizedProof struct {
+	RequestID            RequestID
+	ConsumerImage        string
+	ProverID             peer.ID
+	Proof                ZKProof
+	ValidationSignatures map[peer.ID][]byte // validation peer ID -> validation signature
+}
+
+// FailedAttempt is a proving attempt rejected by the network, ProofID is empty if the prover missed the deadline
+type FailedAttempt struct {
+	ProverID           peer.ID
+	ProofID            ProofID
+	ProofHash          string // empty if the prover missed the deadline
+	TimedOut           bool
+	NegativeSignatures map[peer.ID][]byte // validation peer ID -> signature of the negative vote
+}
+
+// FailureRecord explains why the request failed, it has an entry for every attempted prover
+type FailureRecord struct {
+	RequestID     RequestID
+	ConsumerImage string
+	Attempts      []FailedAttempt
+	FailedAt      int64
+	ReportTxHash  string // set if the failure was reported on-chain
+}
+
+type ProvingAttempt struct {
+	PeerID       peer.ID
+	ProofID      ProofID
+	ValidVotes   int
+	InvalidVotes int
+	TimedOut     bool
+}
+
+type RequestStatus struct {
+	RequestID        RequestID
+	Phase            RequestPhase
+	Attempts         []ProvingAttempt
+	SubmissionTxHash string
+}
+
+type RequestEventType int
+
+const (
+	EventRequestAccepted RequestEventType = iota
+	EventProverSelected
+	EventProofReceived
+	EventValidationVote
+	EventProofFinalized
+	EventSubmissionMined
+	EventProverReselected
+	EventRequestFailed
+	EventProverTimedOut
+)
+
+func (t RequestEventType) String() string {
+	return [...]string{
+		"EventRequestAccepted",
+		"EventProverSelected",
+		"EventProofReceived",
+		"EventValidationVote",
+		"EventProofFinalized",
+		"EventSubmissionMined",
+		"EventProverReselected",
+		"EventRequestFailed",
+		"EventProverTimedOut",
+	}[t]
+}
+
+// IsTerminal reports whether no more events are expected for the request after this one
+func (t RequestEventType) IsTerminal() bool {
+	return t == EventSubmissionMined || t == EventRequestFailed
+}
+
+type RequestEvent struct {
+	RequestID RequestID
+	Type      RequestEventType
+	PeerID    peer.ID // selected prover, proof author or voter depending on the type
+	ProofID   ProofID
+	IsValid   bool // the value of the validation vote
+	TxHash    string
+	Timestamp int64
+}
+
+type EvidenceType int
+
+const (
+	EvidenceInvalidProof    EvidenceType = iota // the prover's proof was voted invalid
+	EvidenceForgedSignature                     // the validation vote isn't signed by its author
+	EvidenceEquivocation                        // the validator signed conflicting votes for the same proof
+	EvidenceInvalidRequest                      // the node published a malformed or unauthorized proving request
+)
+
+func (t EvidenceType) String() string {
+	return [...]string{"EvidenceInvalidProof", "EvidenceForgedSignature", "EvidenceEquivocation", "EvidenceInvalidRequest"}[t]
+}
+
+// IsSlashable reports whether the evidence can be verified by the contract
+func (t EvidenceType) IsSlashable() bool {
+	return t == EvidenceInvalidProof || t == EvidenceEquivocation
+}
+
+// SignedVote is a validation vote together with the voter's signature of ValidationHash
+type SignedVote struct {
+	VoterID   peer.ID
+	IsValid   bool
+	Signature []byte
+}
+
+// Evidence is a self-contained proof of misbehaviour, it can be verified without trusting the reporter
+type Evidence struct {
+	ID            string
+	Type          EvidenceType
+	Offender      peer.ID
+	RequestID     RequestID
+	ProverID      peer.ID      // the prover the votes are about
+	ProofHash     string       // the proof the votes are about, see ProofHash
+	Votes         []SignedVote // the negative votes for the invalid proof or the conflicting votes of the equivocator
+	SignedMessage []byte       // the offender's pubsub message with its libp2p signature
+	Reporter      peer.ID
+	CreatedAt     int64
+	SubmissionTx  string // set once the evidence is submitted to the contract
+}
+
+// RandomnessContribution is a single input of the prover selection randomness,
+// Source is the block number for the block hash randomness and the peer ID for the commit-reveal one
+type RandomnessContribution struct {
+	Source   string
+	Value    []byte
+	Withheld bool // the committer didn't reveal its secret, Value is its commitment
+}
+
+// SelectionRecord keeps everything needed to repeat the prover selection, so any node can audit it afterwards
+type SelectionRecord struct {
+	RequestID     RequestID
+	Attempt       int
+	Source        string // name of the randomness source
+	Contributions []RandomnessContribution
+	Seed          []byte // SelectionSeed of the contributions, the request ID and the attempt
+	Candidates    []peer.ID
+	Policy        string   // name of the selection policy
+	Weights       []uint64 // weights of the candidates given by the policy
+	Selected      peer.ID
+	CreatedAt     int64
+}
+
+// SignedProof is a finalized request with the validators' signatures of its proof, ready for the submission to the contract
+type SignedProof struct {
+	Request    ProvingRequestMessage
+	ProverID   peer.ID
+	ProofHash  string
+	Signatures [][]byte
+}
+
+type OutboxState int
+
+const (
+	OutboxQueued OutboxState = iota // waiting to be sent, or to be sent again after an error
+	OutboxSent                      // waiting to be mined
+	OutboxMined
+	OutboxReverted
+	OutboxFailed // the call kept reverting, the outbox gave up on it
+)
+
+func (s OutboxState) String() string {
+	return [...]string{"OutboxQueued", "OutboxSent", "OutboxMined", "OutboxReverted", "OutboxFailed"}[s]
+}
+
+// IsFinal reports whether the outbox is done with the entry
+func (s OutboxState) IsFinal() bool {
+	return s == OutboxMined || s == OutboxReverted || s == OutboxFailed
+}
+
+// OutboxEntry is a contract call persisted before it's sent, so it survives a stuck transaction or a restart
+type OutboxEntry struct {
+	ID         string // the method and the request or the evidence, a call with the same ID is sent once
+	Method     string
+	Data       []byte // ABI-packed calldata
+	State      OutboxState
+	HasNonce   bool
+	Nonce      uint64
+	GasPrice   *big.Int // set on the chains without EIP-1559
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	TxHashes   []string // every signed version of the transaction, the replacements go last
+	MinedTx    string
+	Attempts   int // the sends that failed because the call reverts
+	LastError  string
+	CreatedAt  int64
+	SentAt     int64 // the last time the transaction was signed, the replacement timeout starts here
+	FinishedAt int64
+	FillNonce  bool // the call was given up before its signed transaction was accepted, a self-transfer takes its nonce
+}
+
+type PayoutState int
+
+const (
+	PayoutPending  PayoutState = iota // waiting for the claim window to end
+	PayoutClaiming                    // the claim transaction is sent
+	PayoutClaimed
+	PayoutFailed // the contract rejected the claim
+)
+
+func (s PayoutState) String() string {
+	return [...]string{"PayoutPending", "PayoutClaiming", "PayoutClaimed", "PayoutFailed"}[s]
+}
+
+// PayoutRecord follows the reward of a proof submitted by this node until it's claimed
+type PayoutRecord struct {
+	RequestID       RequestID
+	ConsumerAddress string
+	Reward          *big.Int
+	SubmissionTx    string
+	SubmittedAt     int64
+	ClaimableAfter  int64 // unix time from the contract, 0 until it's read
+	State           PayoutState
+	ClaimTx         string
+	LastError       string
+}
+
+// Reservation is the reward a consumer committed to in an admitted request, it's held until the reward is claimed
+// or the request fails
+type Reservation struct {
+	RequestID       RequestID
+	ConsumerAddress string
+	Reward          *big.Int
+}
+
+// Earnings sums the rewards of the consumer's requests proved by this node, in wei
+type Earnings struct {
+	ConsumerAddress string
+	Expected        *big.Int // the claim window isn't over yet
+	Claimable       *big.Int // the claim window is over, but the reward isn't claimed yet
+	Claimed         *big.Int
 }
@@ -5,7 +5,6 @@ import (
 	"math/big"
 )
 
-// maybe move to protobuf later
 type Status int
 
 const (
@@ -20,8 +19,9 @@ func (s Status) String() string {
 }
 
 type StatusMessage struct {
-	Status  Status `json:"status"`
-	Payload any    `json:"payload"`
+	Status   Status   `json:"status"`
+	Payload  any      `json:"payload"`
+	Capacity Capacity `json:"capacity"`
 }
 
 type ProvingRequestMessage struct {
@@ -39,6 +39,8 @@ type VotingMessageType int
 const (
 	VoteProverSelection = iota
 	VoteValidation
+	VoteProverDeclined
+	VoteProofSubmitted
 )
 
 type VotingMessage struct {
@@ -53,6 +55,8 @@ const (
 	RequestsTopic Topic = "requests"
 	VotingTopic   Topic = "voting"
 	ProofsTopic   Topic = "proofs"
+	// RandomnessTopic carries the commit-reveal rounds of the prover selection randomness
+	RandomnessTopic Topic = "randomness"
 )
 
 func (t Topic) String() string {
@@ -62,6 +66,7 @@ func (t Topic) String() string {
 type ProverSelectionPayload struct {
 	RequestID RequestID `json:"request_id"`
 	PeerID    peer.ID   `json:"peer_id"`
+	Attempt   int       `json:"attempt"` // the reselections after timeouts and invalid proofs are separate votings
 }
 
 type ProofSubmissionMessage struct {
@@ -70,16 +75,46 @@ type ProofSubmissionMessage struct {
 	Proof     []byte    `json:"proof"`
 }
 
+// ProverDeclinedPayload is sent by the selected prover when its queue is full
+type ProverDeclinedPayload struct {
+	RequestID RequestID `json:"request_id"`
+	Attempt   int       `json:"attempt"`
+}
+
+// ProofSubmittedPayload is sent by the prover once the submission of its finalized proof is mined
+type ProofSubmittedPayload struct {
+	RequestID RequestID `json:"request_id"`
+	TxHash    string    `json:"tx_hash"`
+}
+
 type ValidationPayload struct {
 	RequestID           RequestID `json:"request_id"`
 	ProverID            peer.ID   `json:"prover_id"`
 	IsValid             bool      `json:"is_valid"`
 	ValidationTimestamp int64     `json:"validation_timestamp,omitempty"`
 	Signature           []byte    `json:"signature,omitempty"`
+	ProofHash           string    `json:"proof_hash"`
 }
 
+// DataToSign is the validated proof's data, the contract builds the same JSON, so the field order matters
 type DataToSign struct {
 	RequestID     RequestID `json:"request_id"`
 	ProverAddress string    `json:"prover_address"`
+	ProofHash     string    `json:"proof_hash"`
 	IsValid       bool      `json:"is_valid"`
 }
+
+type RandomnessPhase int
+
+const (
+	RandomnessCommit RandomnessPhase = iota // Value is sha256 of the secret and the author's peer ID
+	RandomnessReveal                        // Value is the secret
+)
+
+type RandomnessMessage struct {
+	RequestID   RequestID          `json:"request_id"`
+	Attempt     int                `json:"attempt"`
+	Phase       RandomnessPhase    `json:"phase"`
+	Value       []byte             `json:"value"`
+	Commitments map[peer.ID][]byte `json:"commitments,omitempty"` // sent with the reveal, the commitments the author got in time
+}
@@ -1,15 +1,23 @@
 package common
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	"math"
+	"math/big"
 	"math/rand"
 	"net"
+	"slices"
+	"strings"
 )
 
 // BytesToRandom converts a byte slice to a random number generator
@@ -87,3 +95,157 @@ func GetRSV(signature []byte) ([32]byte, [32]byte, uint8, error) {
 
 	return [32]byte(signature[:32]), [32]byte(signature[32:64]), 27 + signature[64], nil
 }
+
+// ProvingRequestHash is the hash signed by the consumer, it matches keccak256(abi.encodePacked(requestId, reward)) in the contract
+func ProvingRequestHash(requestID RequestID, reward *big.Int) []byte {
+	if reward == nil {
+		reward = new(big.Int)
+	}
+
+	return ethCrypto.Keccak256([]byte(requestID), ethcommon.LeftPadBytes(reward.Bytes(), 32))
+}
+
+// ParseEther converts a decimal amount of ether to wei
+func ParseEther(amount string) (*big.Int, error) {
+	return parseUnits(amount, params.Ether)
+}
+
+// ParseGwei converts a decimal amount of gwei to wei
+func ParseGwei(amount string) (*big.Int, error) {
+	return parseUnits(amount, params.GWei)
+}
+
+func parseUnits(amount string, unit int64) (*big.Int, error) {
+	r, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return nil, errors.Errorf("invalid amount %s", amount)
+	}
+
+	if r.Sign() <= 0 {
+		return nil, errors.Errorf("amount %s must be positive", amount)
+	}
+
+	r.Mul(r, new(big.Rat).SetInt(big.NewInt(unit)))
+	if !r.IsInt() {
+		return nil, errors.Errorf("amount %s is more precise than a wei", amount)
+	}
+
+	return r.Num(), nil
+}
+
+func RecoverAddress(hash, signature []byte) (ethcommon.Address, error) {
+	pub, err := ethCrypto.SigToPub(hash, signature)
+	if err != nil {
+		return ethcommon.Address{}, errors.Wrap(err, "error converting signature to public key")
+	}
+
+	return ethCrypto.PubkeyToAddress(*pub), nil
+}
+
+// ProofHash identifies the proof in the validation signatures, it's 0x-prefixed keccak256 of the proof
+func ProofHash(proof []byte) string {
+	return "0x" + hex.EncodeToString(ethCrypto.Keccak256(proof))
+}
+
+// ValidationHash is the hash signed by the validators, the contract builds the same JSON in validationOutputToJson
+func ValidationHash(requestID RequestID, proverID peer.ID, proofHash string, isValid bool) ([]byte, error) {
+	proverAddr, err := PeerIDToEthAddress(proverID)
+	if err != nil {
+		return nil, errors.Wrap(err, "error converting peer ID to eth address")
+	}
+
+	b, err := json.Marshal(DataToSign{
+		RequestID:     requestID,
+		ProverAddress: proverAddr,
+		ProofHash:     proofHash,
+		IsValid:       isValid,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling a message")
+	}
+
+	return ethCrypto.Keccak256(b), nil
+}
+
+// IsValidationSignedBy checks that the validation signature was made by the validator's key
+func IsValidationSignedBy(validatorID peer.ID, requestID RequestID, proverID peer.ID, proofHash string, isValid bool, signature []byte) (bool, error) {
+	hash, err := ValidationHash(requestID, proverID, proofHash, isValid)
+	if err != nil {
+		return false, err
+	}
+
+	signer, err := RecoverAddress(hash, signature)
+	if err != nil {
+		return false, err
+	}
+
+	validatorAddr, err := PeerIDToEthAddress(validatorID)
+	if err != nil {
+		return false, errors.Wrap(err, "error converting peer ID to eth address")
+	}
+
+	return signer == ethcommon.HexToAddress(validatorAddr), nil
+}
+
+// SelectionSeed mixes the request ID and the attempt into the randomness, so the requests that share the randomness
+// select different provers
+func SelectionSeed(contributions []RandomnessContribution, requestID RequestID, attempt int) []byte {
+	sorted := slices.Clone(contributions)
+	slices.SortFunc(sorted, func(a, b RandomnessContribution) int {
+		return strings.Compare(a.Source, b.Source)
+	})
+
+	hash := sha256.New()
+	for _, c := range sorted {
+		hash.Write([]byte(c.Source))
+		hash.Write(c.Value)
+	}
+	hash.Write([]byte(requestID))
+	hash.Write(binary.BigEndian.AppendUint32(nil, uint32(attempt)))
+
+	return hash.Sum(nil)
+}
+
+// SelectCandidate picks one of the candidates with the probability proportional to its weight,
+// the candidates have to be sorted in the same way on every node
+func SelectCandidate(seed []byte, candidates []peer.ID, weights []uint64) (peer.ID, error) {
+	if len(candidates) != len(weights) {
+		return "", errors.New("every candidate needs a weight")
+	}
+
+	var total uint64
+	for _, w := range weights {
+		total += w
+	}
+
+	if total == 0 {
+		return "", errors.New("no candidates")
+	}
+
+	random, err := BytesToRandom(seed)
+	if err != nil {
+		return "", errors.Wrap(err, "error getting random from seed")
+	}
+
+	point := uint64(random.Int63n(int64(min(total, math.MaxInt64))))
+	for i, w := range weights {
+		if point < w {
+			return candidates[i], nil
+		}
+		point -= w
+	}
+
+	return candidates[len(candidates)-1], nil
+}
+
+// VerifySelection repeats the selection from the record's contributions
+func VerifySelection(record SelectionRecord) bool {
+	seed := SelectionSeed(record.Contributions, record.RequestID, record.Attempt)
+	if !bytes.Equal(seed, record.Seed) {
+		return false
+	}
+
+	selected, err := SelectCandidate(seed, record.Candidates, record.Weights)
+
+	return err == nil && selected == record.Selected
+}
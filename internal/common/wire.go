@@ -0,0 +1,452 @@
+package common
+
+import (
+	"github.com/dimazhornyk/generic-proving-network/proto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+// conversions between the internal models and the protobuf wire format, see proto/messages.proto
+
+func StatusToProto(msg StatusMessage) (*proto.StatusMessage, error) {
+	res := &proto.StatusMessage{
+		Status: proto.NodeStatus(msg.Status),
+		Capacity: &proto.NodeCapacity{
+			Cores:          uint32(msg.Capacity.Cores),
+			MemoryMb:       msg.Capacity.MemoryMB,
+			Slots:          uint32(msg.Capacity.Slots),
+			ActiveJobs:     uint32(msg.Capacity.ActiveJobs),
+			FreeSlots:      freeSlotsToProto(msg.Capacity.FreeSlots),
+			QueuedJobs:     uint32(msg.Capacity.QueuedJobs),
+			QueueSize:      uint32(msg.Capacity.QueueSize),
+			ProvingTimesMs: msg.Capacity.ProvingTimes,
+		},
+	}
+
+	commitments, ok := msg.Payload.([]string)
+	if !ok && msg.Status == StatusInit {
+		return nil, errors.New("invalid payload type for StatusInit")
+	}
+	res.Commitments = commitments
+
+	return res, nil
+}
+
+func StatusFromProto(msg *proto.StatusMessage) (StatusMessage, error) {
+	status := Status(msg.GetStatus())
+	if status < StatusInit || status > StatusShuttingDown {
+		return StatusMessage{}, errors.Errorf("unknown status: %d", msg.GetStatus())
+	}
+
+	capacity := msg.GetCapacity()
+	res := StatusMessage{
+		Status: status,
+		Capacity: Capacity{
+			Cores:        int(capacity.GetCores()),
+			MemoryMB:     capacity.GetMemoryMb(),
+			Slots:        int(capacity.GetSlots()),
+			ActiveJobs:   int(capacity.GetActiveJobs()),
+			FreeSlots:    freeSlotsFromProto(capacity.GetFreeSlots()),
+			QueuedJobs:   int(capacity.GetQueuedJobs()),
+			QueueSize:    int(capacity.GetQueueSize()),
+			ProvingTimes: capacity.GetProvingTimesMs(),
+		},
+	}
+
+	if status == StatusInit || len(msg.GetCommitments()) > 0 {
+		res.Payload = msg.GetCommitments()
+	}
+
+	return res, nil
+}
+
+func freeSlotsToProto(slots map[string]int) map[string]uint32 {
+	res := make(map[string]uint32, len(slots))
+	for image, n := range slots {
+		res[image] = uint32(n)
+	}
+
+	return res
+}
+
+func freeSlotsFromProto(slots map[string]uint32) map[string]int {
+	res := make(map[string]int, len(slots))
+	for image, n := range slots {
+		res[image] = int(n)
+	}
+
+	return res
+}
+
+func ProvingRequestToProto(msg ProvingRequestMessage) *proto.ProvingRequestMessage {
+	var reward []byte
+	if msg.Reward != nil {
+		reward = msg.Reward.Bytes()
+	}
+
+	return &proto.ProvingRequestMessage{
+		RequestId:       msg.ID,
+		Reward:          reward,
+		ConsumerImage:   msg.ConsumerImage,
+		ConsumerAddress: msg.ConsumerAddress,
+		Signature:       msg.Signature,
+		Data:            msg.Data,
+		Timestamp:       msg.Timestamp,
+	}
+}
+
+func ProvingRequestFromProto(msg *proto.ProvingRequestMessage) ProvingRequestMessage {
+	return ProvingRequestMessage{
+		ID:              msg.GetRequestId(),
+		Reward:          new(big.Int).SetBytes(msg.GetReward()),
+		ConsumerImage:   msg.GetConsumerImage(),
+		ConsumerAddress: msg.GetConsumerAddress(),
+		Signature:       msg.GetSignature(),
+		Data:            msg.GetData(),
+		Timestamp:       msg.GetTimestamp(),
+	}
+}
+
+func VotingToProto(msg VotingMessage) (*proto.VotingMessage, error) {
+	switch payload := msg.Payload.(type) {
+	case ProverSelectionPayload:
+		return &proto.VotingMessage{
+			Payload: &proto.VotingMessage_ProverSelection{
+				ProverSelection: &proto.ProverSelectionPayload{
+					RequestId: payload.RequestID,
+					PeerId:    payload.PeerID.String(),
+					Attempt:   uint32(payload.Attempt),
+				},
+			},
+		}, nil
+	case ValidationPayload:
+		return &proto.VotingMessage{
+			Payload: &proto.VotingMessage_Validation{
+				Validation: &proto.ValidationPayload{
+					RequestId:           payload.RequestID,
+					ProverId:            payload.ProverID.String(),
+					IsValid:             payload.IsValid,
+					ValidationTimestamp: payload.ValidationTimestamp,
+					Signature:           payload.Signature,
+					ProofHash:           payload.ProofHash,
+				},
+			},
+		}, nil
+	case ProverDeclinedPayload:
+		return &proto.VotingMessage{
+			Payload: &proto.VotingMessage_ProverDeclined{
+				ProverDeclined: &proto.ProverDeclinedPayload{
+					RequestId: payload.RequestID,
+					Attempt:   uint32(payload.Attempt),
+				},
+			},
+		}, nil
+	case ProofSubmittedPayload:
+		return &proto.VotingMessage{
+			Payload: &proto.VotingMessage_ProofSubmitted{
+				ProofSubmitted: &proto.ProofSubmittedPayload{
+					RequestId: payload.RequestID,
+					TxHash:    payload.TxHash,
+				},
+			},
+		}, nil
+	default:
+		return nil, errors.Errorf("unknown voting payload type: %T", msg.Payload)
+	}
+}
+
+func VotingFromProto(msg *proto.VotingMessage) (VotingMessage, error) {
+	switch payload := msg.GetPayload().(type) {
+	case *proto.VotingMessage_ProverSelection:
+		peerID, err := peer.Decode(payload.ProverSelection.GetPeerId())
+		if err != nil {
+			return VotingMessage{}, errors.Wrap(err, "error decoding selected peer ID")
+		}
+
+		return VotingMessage{
+			Type: VoteProverSelection,
+			Payload: ProverSelectionPayload{
+				RequestID: payload.ProverSelection.GetRequestId(),
+				PeerID:    peerID,
+				Attempt:   int(payload.ProverSelection.GetAttempt()),
+			},
+		}, nil
+	case *proto.VotingMessage_Validation:
+		proverID, err := peer.Decode(payload.Validation.GetProverId())
+		if err != nil {
+			return VotingMessage{}, errors.Wrap(err, "error decoding prover ID")
+		}
+
+		return VotingMessage{
+			Type: VoteValidation,
+			Payload: ValidationPayload{
+				RequestID:           payload.Validation.GetRequestId(),
+				ProverID:            proverID,
+				IsValid:             payload.Validation.GetIsValid(),
+				ValidationTimestamp: payload.Validation.GetValidationTimestamp(),
+				Signature:           payload.Validation.GetSignature(),
+				ProofHash:           payload.Validation.GetProofHash(),
+			},
+		}, nil
+	case *proto.VotingMessage_ProverDeclined:
+		return VotingMessage{
+			Type: VoteProverDeclined,
+			Payload: ProverDeclinedPayload{
+				RequestID: payload.ProverDeclined.GetRequestId(),
+				Attempt:   int(payload.ProverDeclined.GetAttempt()),
+			},
+		}, nil
+	case *proto.VotingMessage_ProofSubmitted:
+		return VotingMessage{
+			Type: VoteProofSubmitted,
+			Payload: ProofSubmittedPayload{
+				RequestID: payload.ProofSubmitted.GetRequestId(),
+				TxHash:    payload.ProofSubmitted.GetTxHash(),
+			},
+		}, nil
+	default:
+		return VotingMessage{}, errors.New("empty voting payload")
+	}
+}
+
+func ProofSubmissionToProto(msg ProofSubmissionMessage) *proto.ProofSubmissionMessage {
+	return &proto.ProofSubmissionMessage{
+		RequestId: msg.RequestID,
+		ProofId:   msg.ProofID,
+		Proof:     msg.Proof,
+	}
+}
+
+func ProofSubmissionFromProto(msg *proto.ProofSubmissionMessage) ProofSubmissionMessage {
+	return ProofSubmissionMessage{
+		RequestID: msg.GetRequestId(),
+		ProofID:   msg.GetProofId(),
+		Proof:     msg.GetProof(),
+	}
+}
+
+func RandomnessToProto(msg RandomnessMessage) *proto.RandomnessMessage {
+	res := &proto.RandomnessMessage{
+		RequestId:   msg.RequestID,
+		Attempt:     uint32(msg.Attempt),
+		Phase:       proto.RandomnessPhase(msg.Phase), // both enums are declared in the same order
+		Value:       msg.Value,
+		Commitments: make([]*proto.RandomnessCommitment, 0, len(msg.Commitments)),
+	}
+
+	for peerID, commitment := range msg.Commitments {
+		res.Commitments = append(res.Commitments, &proto.RandomnessCommitment{
+			PeerId:     peerID.String(),
+			Commitment: commitment,
+		})
+	}
+
+	return res
+}
+
+func RandomnessFromProto(msg *proto.RandomnessMessage) (RandomnessMessage, error) {
+	phase := RandomnessPhase(msg.GetPhase())
+	if phase != RandomnessCommit && phase != RandomnessReveal {
+		return RandomnessMessage{}, errors.Errorf("unknown randomness phase: %d", msg.GetPhase())
+	}
+
+	res := RandomnessMessage{
+		RequestID:   msg.GetRequestId(),
+		Attempt:     int(msg.GetAttempt()),
+		Phase:       phase,
+		Value:       msg.GetValue(),
+		Commitments: make(map[peer.ID][]byte, len(msg.GetCommitments())),
+	}
+
+	for _, c := range msg.GetCommitments() {
+		peerID, err := peer.Decode(c.GetPeerId())
+		if err != nil {
+			return RandomnessMessage{}, errors.Wrap(err, "error decoding committer ID")
+		}
+
+		res.Commitments[peerID] = c.GetCommitment()
+	}
+
+	return res, nil
+}
+
+func ZKProofToProto(proof ZKProof) *proto.ZKProof {
+	return &proto.ZKProof{
+		ProofId:   proof.ProofID,
+		Proof:     proof.Proof,
+		Timestamp: proof.Timestamp,
+	}
+}
+
+func ZKProofFromProto(proof *proto.ZKProof) ZKProof {
+	return ZKProof{
+		ProofID:   proof.GetProofId(),
+		Proof:     proof.GetProof(),
+		Timestamp: proof.GetTimestamp(),
+	}
+}
+
+func RequestExtensionToProto(req RequestExtension) *proto.RequestExtension {
+	res := &proto.RequestExtension{
+		Request:              ProvingRequestToProto(req.ProvingRequestMessage),
+		Phase:                proto.RequestPhase(req.Phase),
+		ProvingPeers:         Map(req.ProvingPeers, peer.ID.String),
+		Proofs:               make(map[string]*proto.ZKProof, len(req.Proofs)),
+		ValidationSignatures: make(map[string]*proto.PeerSignatures, len(req.ValidationSignatures)),
+		ValidationVotes:      make(map[string]*proto.PeerVotes, len(req.ValidationVotes)),
+		SubmissionTxHash:     req.SubmissionTxHash,
+		ProvingStartedAt:     req.ProvingStartedAt,
+		TimedOutPeers:        Map(req.TimedOutPeers, peer.ID.String),
+		SelectionStartedAt:   req.SelectionStartedAt,
+	}
+
+	for proverID, proof := range req.Proofs {
+		res.Proofs[proverID.String()] = ZKProofToProto(proof)
+	}
+
+	for proverID, signatures := range req.ValidationSignatures {
+		sigs := &proto.PeerSignatures{Signatures: make(map[string][]byte, len(signatures))}
+		for voterID, signature := range signatures {
+			sigs.Signatures[voterID.String()] = signature
+		}
+
+		res.ValidationSignatures[proverID.String()] = sigs
+	}
+
+	for proverID, votes := range req.ValidationVotes {
+		vs := &proto.PeerVotes{Votes: make(map[string]bool, len(votes))}
+		for voterID, isValid := range votes {
+			vs.Votes[voterID.String()] = isValid
+		}
+
+		res.ValidationVotes[proverID.String()] = vs
+	}
+
+	return res
+}
+
+func RequestExtensionFromProto(req *proto.RequestExtension) (RequestExtension, error) {
+	phase := RequestPhase(req.GetPhase())
+	if phase < PhaseUnknown || phase > PhaseFinalized {
+		return RequestExtension{}, errors.Errorf("unknown request phase: %d", req.GetPhase())
+	}
+
+	res := RequestExtension{
+		ProvingRequestMessage: ProvingRequestFromProto(req.GetRequest()),
+		Phase:                 phase,
+		ProvingPeers:          make([]peer.ID, 0, len(req.GetProvingPeers())),
+		Proofs:                make(map[peer.ID]ZKProof, len(req.GetProofs())),
+		ValidationSignatures:  make(map[peer.ID]map[peer.ID][]byte, len(req.GetValidationSignatures())),
+		ValidationVotes:       make(map[peer.ID]map[peer.ID]bool, len(req.GetValidationVotes())),
+		SubmissionTxHash:      req.GetSubmissionTxHash(),
+		ProvingStartedAt:      req.GetProvingStartedAt(),
+		TimedOutPeers:         make([]peer.ID, 0, len(req.GetTimedOutPeers())),
+		SelectionStartedAt:    req.GetSelectionStartedAt(),
+	}
+
+	for _, s := range req.GetProvingPeers() {
+		peerID, err := peer.Decode(s)
+		if err != nil {
+			return RequestExtension{}, errors.Wrap(err, "error decoding proving peer ID")
+		}
+
+		res.ProvingPeers = append(res.ProvingPeers, peerID)
+	}
+
+	for _, s := range req.GetTimedOutPeers() {
+		peerID, err := peer.Decode(s)
+		if err != nil {
+			return RequestExtension{}, errors.Wrap(err, "error decoding timed out peer ID")
+		}
+
+		res.TimedOutPeers = append(res.TimedOutPeers, peerID)
+	}
+
+	for s, proof := range req.GetProofs() {
+		proverID, err := peer.Decode(s)
+		if err != nil {
+			return RequestExtension{}, errors.Wrap(err, "error decoding prover ID")
+		}
+
+		res.Proofs[proverID] = ZKProofFromProto(proof)
+	}
+
+	for s, signatures := range req.GetValidationSignatures() {
+		proverID, err := peer.Decode(s)
+		if err != nil {
+			return RequestExtension{}, errors.Wrap(err, "error decoding prover ID")
+		}
+
+		res.ValidationSignatures[proverID] = make(map[peer.ID][]byte, len(signatures.GetSignatures()))
+		for v, signature := range signatures.GetSignatures() {
+			voterID, err := peer.Decode(v)
+			if err != nil {
+				return RequestExtension{}, errors.Wrap(err, "error decoding voter ID")
+			}
+
+			res.ValidationSignatures[proverID][voterID] = signature
+		}
+	}
+
+	for s, votes := range req.GetValidationVotes() {
+		proverID, err := peer.Decode(s)
+		if err != nil {
+			return RequestExtension{}, errors.Wrap(err, "error decoding prover ID")
+		}
+
+		res.ValidationVotes[proverID] = make(map[peer.ID]bool, len(votes.GetVotes()))
+		for v, isValid := range votes.GetVotes() {
+			voterID, err := peer.Decode(v)
+			if err != nil {
+				return RequestExtension{}, errors.Wrap(err, "error decoding voter ID")
+			}
+
+			res.ValidationVotes[proverID][voterID] = isValid
+		}
+	}
+
+	return res, nil
+}
+
+func FinalizedProofToProto(proof FinalizedProof) *proto.FinalizedProof {
+	res := &proto.FinalizedProof{
+		RequestId:            proof.RequestID,
+		ConsumerImage:        proof.ConsumerImage,
+		ProverId:             proof.ProverID.String(),
+		Proof:                ZKProofToProto(proof.Proof),
+		ValidationSignatures: make(map[string][]byte, len(proof.ValidationSignatures)),
+	}
+
+	for voterID, signature := range proof.ValidationSignatures {
+		res.ValidationSignatures[voterID.String()] = signature
+	}
+
+	return res
+}
+
+func FinalizedProofFromProto(proof *proto.FinalizedProof) (FinalizedProof, error) {
+	proverID, err := peer.Decode(proof.GetProverId())
+	if err != nil {
+		return FinalizedProof{}, errors.Wrap(err, "error decoding prover ID")
+	}
+
+	res := FinalizedProof{
+		RequestID:            proof.GetRequestId(),
+		ConsumerImage:        proof.GetConsumerImage(),
+		ProverID:             proverID,
+		Proof:                ZKProofFromProto(proof.GetProof()),
+		ValidationSignatures: make(map[peer.ID][]byte, len(proof.GetValidationSignatures())),
+	}
+
+	for v, signature := range proof.GetValidationSignatures() {
+		voterID, err := peer.Decode(v)
+		if err != nil {
+			return FinalizedProof{}, errors.Wrap(err, "error decoding voter ID")
+		}
+
+		res.ValidationSignatures[voterID] = signature
+	}
+
+	return res, nil
+}
@@ -0,0 +1,142 @@
+package connectors
+
+import (
+	"context"
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/tracing"
+	"github.com/dimazhornyk/generic-proving-network/proto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// WireVersion is the version of the messages in proto/messages.proto, bump it on the incompatible changes
+const WireVersion = 1
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported message version")
+	ErrSenderMismatch     = errors.New("envelope sender doesn't match the message author")
+)
+
+func EncodeEnvelope(ctx context.Context, msgType proto.MessageType, sender peer.ID, payload protobuf.Message) ([]byte, error) {
+	b, err := protobuf.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding a payload")
+	}
+
+	return protobuf.Marshal(&proto.Envelope{
+		Type:         msgType,
+		Version:      WireVersion,
+		Sender:       sender.String(),
+		Payload:      b,
+		TraceContext: tracing.Inject(ctx),
+	})
+}
+
+// DecodeEnvelope checks the version of the envelope and returns ctx carrying the sender's trace context
+func DecodeEnvelope(ctx context.Context, data []byte) (context.Context, *proto.Envelope, error) {
+	var envelope proto.Envelope
+	if err := protobuf.Unmarshal(data, &envelope); err != nil {
+		return ctx, nil, errors.Wrap(err, "error decoding an envelope")
+	}
+
+	if envelope.GetVersion() != WireVersion {
+		return ctx, nil, errors.Wrapf(ErrUnsupportedVersion, "got %d, supported %d", envelope.GetVersion(), WireVersion)
+	}
+
+	return tracing.Extract(ctx, envelope.GetTraceContext()), &envelope, nil
+}
+
+// DecodeMessage decodes a pubsub message signed by author into dest and returns ctx carrying the sender's trace context
+func DecodeMessage(ctx context.Context, author peer.ID, data []byte, dest any) (context.Context, error) {
+	ctx, envelope, err := DecodeEnvelope(ctx, data)
+	if err != nil {
+		return ctx, err
+	}
+
+	if envelope.GetSender() != author.String() {
+		return ctx, errors.Wrapf(ErrSenderMismatch, "sender %s, author %s", envelope.GetSender(), author.String())
+	}
+
+	switch d := dest.(type) {
+	case *common.StatusMessage:
+		var msg proto.StatusMessage
+		if err := unmarshalPayload(envelope, proto.MessageType_MESSAGE_TYPE_STATUS, &msg); err != nil {
+			return ctx, err
+		}
+
+		*d, err = common.StatusFromProto(&msg)
+	case *common.ProvingRequestMessage:
+		var msg proto.ProvingRequestMessage
+		if err := unmarshalPayload(envelope, proto.MessageType_MESSAGE_TYPE_PROVING_REQUEST, &msg); err != nil {
+			return ctx, err
+		}
+
+		*d = common.ProvingRequestFromProto(&msg)
+	case *common.VotingMessage:
+		var msg proto.VotingMessage
+		if err := unmarshalPayload(envelope, proto.MessageType_MESSAGE_TYPE_VOTING, &msg); err != nil {
+			return ctx, err
+		}
+
+		*d, err = common.VotingFromProto(&msg)
+	case *common.ProofSubmissionMessage:
+		var msg proto.ProofSubmissionMessage
+		if err := unmarshalPayload(envelope, proto.MessageType_MESSAGE_TYPE_PROOF_SUBMISSION, &msg); err != nil {
+			return ctx, err
+		}
+
+		*d = common.ProofSubmissionFromProto(&msg)
+	case *common.RandomnessMessage:
+		var msg proto.RandomnessMessage
+		if err := unmarshalPayload(envelope, proto.MessageType_MESSAGE_TYPE_RANDOMNESS, &msg); err != nil {
+			return ctx, err
+		}
+
+		*d, err = common.RandomnessFromProto(&msg)
+	default:
+		return ctx, errors.Errorf("unknown message type: %T", dest)
+	}
+
+	return ctx, err
+}
+
+func encodeMessage(ctx context.Context, sender peer.ID, msg any) ([]byte, error) {
+	var msgType proto.MessageType
+	var payload protobuf.Message
+	var err error
+
+	switch m := msg.(type) {
+	case common.StatusMessage:
+		msgType = proto.MessageType_MESSAGE_TYPE_STATUS
+		payload, err = common.StatusToProto(m)
+	case common.ProvingRequestMessage:
+		msgType = proto.MessageType_MESSAGE_TYPE_PROVING_REQUEST
+		payload = common.ProvingRequestToProto(m)
+	case common.VotingMessage:
+		msgType = proto.MessageType_MESSAGE_TYPE_VOTING
+		payload, err = common.VotingToProto(m)
+	case common.ProofSubmissionMessage:
+		msgType = proto.MessageType_MESSAGE_TYPE_PROOF_SUBMISSION
+		payload = common.ProofSubmissionToProto(m)
+	case common.RandomnessMessage:
+		msgType = proto.MessageType_MESSAGE_TYPE_RANDOMNESS
+		payload = common.RandomnessToProto(m)
+	default:
+		return nil, errors.Errorf("unknown message type: %T", msg)
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error converting a message")
+	}
+
+	return EncodeEnvelope(ctx, msgType, sender, payload)
+}
+
+func unmarshalPayload(envelope *proto.Envelope, expected proto.MessageType, dest protobuf.Message) error {
+	if envelope.GetType() != expected {
+		return errors.Errorf("unexpected message type: %s", envelope.GetType().String())
+	}
+
+	return errors.Wrap(protobuf.Unmarshal(envelope.GetPayload(), dest), "error decoding a payload")
+}
@@ -1,43 +1,125 @@
 package connectors
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
 	gpn "github.com/dimazhornyk/generic-proving-network/internal/abi"
 	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/tracing"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	ethcommon "github.com/ethereum/go-ethereum/common"
-	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
-	"log/slog"
+	"go.opentelemetry.io/otel/trace"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
 )
 
+const (
+	submitSignedProofMethod   = "submitSignedProof"
+	submitSignedProofsMethod  = "submitSignedProofs"
+	claimRewardMethod         = "claimReward"
+	reportFailedRequestMethod = "reportFailedRequest"
+	slashInvalidProofMethod   = "slashInvalidProof"
+	slashEquivocationMethod   = "slashEquivocation"
+)
+
+const blockPollInterval = 2 * time.Second
+
+var ErrNotSlashable = errors.New("evidence can't be verified by the contract")
+var ErrNoPayout = errors.New("contract has no payout for the request")
+var ErrNoRejectedProofs = errors.New("none of the proofs has enough negative votes to report the failure")
+
+// MinInvalidProofVotes mirrors MIN_INVALID_PROOF_VOTES of the contract
+const MinInvalidProofVotes = 2
+
+// slashReasons mirrors the SLASH_REASON constants of the contract
+var slashReasons = map[common.EvidenceType]uint8{
+	common.EvidenceInvalidProof: 0,
+	common.EvidenceEquivocation: 1,
+}
+
+// EthBackend is the part of the node API the connector uses, both ethclient.Client and go-ethereum's simulated backend implement it
+type EthBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
 type Ethereum struct {
 	address   ethcommon.Address
 	client    *gpn.ProvingNetwork
-	ethClient *ethclient.Client
+	ethClient EthBackend
+	abi       *abi.ABI
+	outbox    *Outbox
 }
 
-func NewEthereum(cfg *common.Config, privateKey *ecdsa.PrivateKey) (*Ethereum, error) {
-	ethClient, err := ethclient.Dial(cfg.EthereumAPI)
+// NewEthereum signs the transactions for the chain ID reported by the RPC
+func NewEthereum(ctx context.Context, cfg *common.Config, privateKey *ecdsa.PrivateKey, storage StorageBackend) (*Ethereum, error) {
+	ethClient, err := ethclient.DialContext(ctx, cfg.EthereumAPI)
 	if err != nil {
 		return nil, err
 	}
 
+	chainID, err := ethClient.ChainID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting the chain ID")
+	}
+
+	return NewEthereumWithBackend(cfg, privateKey, ethClient, chainID, storage)
+}
+
+// NewEthereumWithBackend takes the chain ID explicitly, since the simulated backend doesn't report it
+func NewEthereumWithBackend(cfg *common.Config, privateKey *ecdsa.PrivateKey, backend EthBackend, chainID *big.Int, storage StorageBackend) (*Ethereum, error) {
 	contractAddr := ethcommon.HexToAddress(cfg.ContractAddress)
-	client, err := gpn.NewProvingNetwork(contractAddr, ethClient)
+	client, err := gpn.NewProvingNetwork(contractAddr, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := gpn.ProvingNetworkMetaData.GetAbi()
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing the contract ABI")
+	}
+
+	transactor, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating the transactor")
+	}
+
+	contract := bind.NewBoundContract(contractAddr, *parsed, backend, backend, backend)
+	outbox, err := NewOutbox(cfg, storage, backend, contract, transactor)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Ethereum{
-		address:   ethCrypto.PubkeyToAddress(privateKey.PublicKey),
+		address:   transactor.From,
 		client:    client,
-		ethClient: ethClient,
+		ethClient: backend,
+		abi:       parsed,
+		outbox:    outbox,
 	}, nil
 }
 
+// RunOutbox sends the contract calls, including the ones left from the previous run
+func (e *Ethereum) RunOutbox(ctx context.Context) {
+	e.outbox.Run(ctx)
+}
+
+func (e *Ethereum) ListOutbox() []common.OutboxEntry {
+	return e.outbox.List()
+}
+
 func (e *Ethereum) GetAllConsumers(ctx context.Context) ([]common.Consumer, error) {
 	opts := &bind.CallOpts{
 		Context: ctx,
@@ -70,6 +152,104 @@ func (e *Ethereum) GetAllProvers(ctx context.Context) ([]ethcommon.Address, erro
 	return e.client.GetProvers(opts)
 }
 
+// GetProverStake returns the prover's deposit, it's zero for the withdrawn provers
+func (e *Ethereum) GetProverStake(ctx context.Context, addr ethcommon.Address) (*big.Int, error) {
+	opts := &bind.CallOpts{
+		Context: ctx,
+		From:    e.address,
+	}
+
+	return e.client.Provers(opts, addr)
+}
+
+// FirstBlockAfter returns the number of the first block mined after the timestamp, it waits for the block if needed
+func (e *Ethereum) FirstBlockAfter(ctx context.Context, timestamp uint64) (uint64, error) {
+	latest, err := e.waitHeader(ctx, func(h *types.Header) bool {
+		return h.Time > timestamp
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// galloping back from the latest block, the requests are usually only a few blocks old
+	hi := latest.Number.Uint64()
+	lo := hi
+	for step := uint64(1); lo > 0; step *= 2 {
+		if step > lo {
+			step = lo
+		}
+
+		header, err := e.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(lo-step))
+		if err != nil {
+			return 0, errors.Wrap(err, "error getting block header")
+		}
+
+		if header.Time <= timestamp {
+			lo -= step
+			break
+		}
+
+		hi = lo - step
+		lo -= step
+	}
+
+	// lo is mined at or before the timestamp or is the genesis, hi is mined after it
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		header, err := e.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(mid))
+		if err != nil {
+			return 0, errors.Wrap(err, "error getting block header")
+		}
+
+		if header.Time > timestamp {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+
+	return hi, nil
+}
+
+// WaitForBlock returns the header of the block, waiting until it's mined
+func (e *Ethereum) WaitForBlock(ctx context.Context, number uint64) (*types.Header, error) {
+	if _, err := e.waitHeader(ctx, func(h *types.Header) bool {
+		return h.Number.Uint64() >= number
+	}); err != nil {
+		return nil, err
+	}
+
+	header, err := e.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting block header")
+	}
+
+	return header, nil
+}
+
+// waitHeader polls the latest block until it satisfies the condition
+func (e *Ethereum) waitHeader(ctx context.Context, cond func(*types.Header) bool) (*types.Header, error) {
+	ticker := time.NewTicker(blockPollInterval)
+	defer ticker.Stop()
+
+	for {
+		header, err := e.ethClient.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting the latest block header")
+		}
+
+		if cond(header) {
+			return header, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
 func (e *Ethereum) ListenForNewProvers(ctx context.Context) (<-chan *gpn.ProvingNetworkProverUpdate, error) {
 	opts := &bind.WatchOpts{
 		Context: ctx,
@@ -108,45 +288,384 @@ func (e *Ethereum) ListenForNewConsumers(ctx context.Context) (<-chan *gpn.Provi
 	return ch, nil
 }
 
-func (e *Ethereum) SubmitValidationSignatures(ctx context.Context, request common.ProvingRequestMessage, signatures [][]byte) error {
-	if len(signatures) == 0 {
-		return errors.New("no signatures provided")
+// SubmitValidationSignatures returns the hash of the mined transaction
+func (e *Ethereum) SubmitValidationSignatures(ctx context.Context, proof common.SignedProof) (txHash string, err error) {
+	request := proof.Request
+	ctx, span := tracing.Start(ctx, "Ethereum.SubmitValidationSignatures", trace.WithAttributes(tracing.RequestID(request.ID)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	rs, ss, vs, err := signedProofSignatures(proof)
+	if err != nil {
+		return "", err
+	}
+
+	txHash, err = e.submit(ctx, submitSignedProofMethod+"/"+request.ID, submitSignedProofMethod, request.ID, request.Reward, ethcommon.HexToHash(proof.ProofHash), rs, ss, vs)
+
+	return txHash, errors.Wrap(err, "error submitting signed proof")
+}
+
+// SubmitSignedProofs submits several finalized requests in one transaction, returns the hash of the mined transaction.
+// The whole batch reverts if any of the requests is rejected by the contract
+func (e *Ethereum) SubmitSignedProofs(ctx context.Context, proofs []common.SignedProof) (txHash string, err error) {
+	ctx, span := tracing.Start(ctx, "Ethereum.SubmitSignedProofs")
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	ids := make([]string, 0, len(proofs))
+	rewards := make([]*big.Int, 0, len(proofs))
+	proofHashes := make([][32]byte, 0, len(proofs))
+	counts := make([]uint8, 0, len(proofs))
+	var rs, ss [][32]byte
+	var vs []uint8
+	for _, proof := range proofs {
+		r, s, v, err := signedProofSignatures(proof)
+		if err != nil {
+			return "", errors.Wrapf(err, "request %s", proof.Request.ID)
+		}
+
+		if len(r) > math.MaxUint8 {
+			return "", errors.Errorf("too many signatures for request %s", proof.Request.ID)
+		}
+
+		ids = append(ids, proof.Request.ID)
+		rewards = append(rewards, proof.Request.Reward)
+		proofHashes = append(proofHashes, ethcommon.HexToHash(proof.ProofHash))
+		counts = append(counts, uint8(len(r)))
+		rs, ss, vs = append(rs, r...), append(ss, s...), append(vs, v...)
+	}
+
+	// the same batch is sent once, the ids are in the order of submission
+	hash := sha256.Sum256([]byte(strings.Join(ids, "\n")))
+	id := submitSignedProofsMethod + "/" + hex.EncodeToString(hash[:])
+
+	txHash, err = e.submit(ctx, id, submitSignedProofsMethod, ids, rewards, proofHashes, counts, rs, ss, vs)
+
+	return txHash, errors.Wrap(err, "error submitting signed proofs")
+}
+
+// GetPayout returns the end of the claim window of the request in unix time, and whether the reward is claimed
+func (e *Ethereum) GetPayout(ctx context.Context, requestID common.RequestID) (claimableAfter int64, claimed bool, err error) {
+	opts := &bind.CallOpts{
+		Context: ctx,
+		From:    e.address,
+	}
+
+	payout, err := e.client.Payouts(opts, requestID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if payout.Consumer == (ethcommon.Address{}) {
+		return 0, false, errors.Wrap(ErrNoPayout, requestID)
 	}
 
-	opts := &bind.TransactOpts{
+	return payout.ClaimableAfterTimestamp.Int64(), payout.Claimed, nil
+}
+
+// IsRequestFailed reports whether the failure of the request is already recorded by the contract
+func (e *Ethereum) IsRequestFailed(ctx context.Context, requestID common.RequestID) (bool, error) {
+	opts := &bind.CallOpts{
 		Context: ctx,
 		From:    e.address,
 	}
 
-	var err error
-	rs := make([][32]byte, len(signatures)+1)
-	ss := make([][32]byte, len(signatures)+1)
-	vs := make([]uint8, len(signatures)+1)
+	return e.client.FailedRequests(opts, requestID)
+}
+
+// IsSlashed reports whether the contract has already slashed the offender for the evidence
+func (e *Ethereum) IsSlashed(ctx context.Context, evidence common.Evidence) (bool, error) {
+	reason, ok := slashReasons[evidence.Type]
+	if !ok {
+		return false, errors.Wrap(ErrNotSlashable, evidence.Type.String())
+	}
+
+	offender, err := common.PeerIDToEthAddress(evidence.Offender)
+	if err != nil {
+		return false, errors.Wrap(err, "error converting offender ID to ethereum address")
+	}
 
-	// consumer's signature is a first element in the signatures array
+	opts := &bind.CallOpts{
+		Context: ctx,
+		From:    e.address,
+	}
+
+	key := crypto.Keccak256Hash([]byte(evidence.RequestID), ethcommon.HexToAddress(offender).Bytes(), []byte{reason})
+
+	return e.client.Slashed(opts, key)
+}
+
+// ClaimReward moves the reward of the request to the node's deposit, returns the hash of the mined transaction
+func (e *Ethereum) ClaimReward(ctx context.Context, requestID common.RequestID) (txHash string, err error) {
+	ctx, span := tracing.Start(ctx, "Ethereum.ClaimReward", trace.WithAttributes(tracing.RequestID(requestID)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	txHash, err = e.submit(ctx, claimRewardMethod+"/"+requestID, claimRewardMethod, requestID)
+
+	return txHash, errors.Wrap(err, "error claiming the reward")
+}
+
+// BlockTime returns the timestamp of the latest block, the contract compares the claim windows with it
+func (e *Ethereum) BlockTime(ctx context.Context) (int64, error) {
+	header, err := e.ethClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "error getting the latest header")
+	}
+
+	return int64(header.Time), nil
+}
+
+// ReportFailedRequest sends the negative validation signatures of every failed attempt to the contract,
+// returns the hash of the mined transaction
+func (e *Ethereum) ReportFailedRequest(ctx context.Context, request common.ProvingRequestMessage, record common.FailureRecord) (txHash string, err error) {
+	ctx, span := tracing.Start(ctx, "Ethereum.ReportFailedRequest", trace.WithAttributes(tracing.RequestID(request.ID)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	provers := make([]ethcommon.Address, 0, len(record.Attempts))
+	proofHashes := make([][32]byte, 0, len(record.Attempts))
+	counts := make([]uint8, 0, len(record.Attempts))
+
+	// consumer's signature is a first element in the signatures array, then go the signatures grouped by prover
+	rs := make([][32]byte, 1)
+	ss := make([][32]byte, 1)
+	vs := make([]uint8, 1)
 	rs[0], ss[0], vs[0], err = common.GetRSV(request.Signature)
 	if err != nil {
-		return errors.Wrap(err, "error getting RSV of the consumer's signature")
+		return "", errors.Wrap(err, "error getting RSV of the consumer's signature")
 	}
 
-	for i, signature := range signatures {
-		rs[i+1], ss[i+1], vs[i+1], err = common.GetRSV(signature)
+	rejected := false
+	for _, attempt := range record.Attempts {
+		addr, err := common.PeerIDToEthAddress(attempt.ProverID)
+		if err != nil {
+			return "", errors.Wrap(err, "error converting prover ID to ethereum address")
+		}
+
+		if len(attempt.NegativeSignatures) > math.MaxUint8 {
+			return "", errors.Errorf("too many signatures for prover %s", addr)
+		}
+
+		provers = append(provers, ethcommon.HexToAddress(addr))
+		proofHashes = append(proofHashes, ethcommon.HexToHash(attempt.ProofHash))
+
+		// the contract takes the attempts with too few votes only as the missed deadlines
+		if len(attempt.NegativeSignatures) < MinInvalidProofVotes {
+			counts = append(counts, 0)
+
+			continue
+		}
+
+		signatures := make([][]byte, 0, len(attempt.NegativeSignatures))
+		for _, signature := range attempt.NegativeSignatures {
+			signatures = append(signatures, signature)
+		}
+
+		attemptRs, attemptSs, attemptVs, err := sortedValidationSignatures(request.ID, attempt.ProverID, attempt.ProofHash, false, signatures)
 		if err != nil {
-			return errors.Wrap(err, "error getting RSV of the validator's signature")
+			return "", err
 		}
+
+		counts = append(counts, uint8(len(signatures)))
+		rs = append(rs, attemptRs...)
+		ss = append(ss, attemptSs...)
+		vs = append(vs, attemptVs...)
+		rejected = true
+	}
+
+	if !rejected {
+		return "", ErrNoRejectedProofs
+	}
+
+	txHash, err = e.submit(ctx, reportFailedRequestMethod+"/"+request.ID, reportFailedRequestMethod, request.ID, request.Reward, provers, proofHashes, counts, rs, ss, vs)
+
+	return txHash, errors.Wrap(err, "error reporting failed request")
+}
+
+// SubmitEvidence sends the slashable evidence to the contract, returns the hash of the mined transaction
+func (e *Ethereum) SubmitEvidence(ctx context.Context, evidence common.Evidence) (txHash string, err error) {
+	ctx, span := tracing.Start(ctx, "Ethereum.SubmitEvidence", trace.WithAttributes(
+		tracing.RequestID(evidence.RequestID),
+		tracing.PeerID(evidence.Offender),
+	))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	proverAddr, err := common.PeerIDToEthAddress(evidence.ProverID)
+	if err != nil {
+		return "", errors.Wrap(err, "error converting prover ID to ethereum address")
+	}
+
+	switch evidence.Type {
+	case common.EvidenceInvalidProof:
+		txHash, err = e.slashInvalidProof(ctx, evidence, ethcommon.HexToAddress(proverAddr))
+	case common.EvidenceEquivocation:
+		txHash, err = e.slashEquivocation(ctx, evidence, ethcommon.HexToAddress(proverAddr))
+	default:
+		return "", errors.Wrap(ErrNotSlashable, evidence.Type.String())
+	}
+
+	return txHash, errors.Wrap(err, "error submitting evidence")
+}
+
+// slashInvalidProof checks the conditions of the contract first, the evidence that can't be slashed isn't sent to revert
+func (e *Ethereum) slashInvalidProof(ctx context.Context, evidence common.Evidence, prover ethcommon.Address) (string, error) {
+	rs, ss, vs, err := invalidProofSignatures(evidence)
+	if err != nil {
+		return "", err
+	}
+
+	opts := &bind.CallOpts{
+		Context: ctx,
+		From:    e.address,
+	}
+
+	proofHash := ethcommon.HexToHash(evidence.ProofHash)
+	rejected, err := e.client.RejectedProofs(opts, crypto.Keccak256Hash([]byte(evidence.RequestID), prover.Bytes()))
+	if err != nil {
+		return "", errors.Wrap(err, "error getting the rejected proof")
 	}
 
-	tx, err := e.client.SubmitSignedProof(opts, request.ID, request.Reward, rs, ss, vs)
+	if rejected != proofHash {
+		return "", errors.Wrap(ErrNotSlashable, "the proof isn't rejected by the failure report")
+	}
+
+	quorum, err := e.client.ValidationQuorum(opts, prover)
+	if err != nil {
+		return "", errors.Wrap(err, "error getting the validation quorum")
+	}
+
+	if big.NewInt(int64(len(rs))).Cmp(quorum) < 0 {
+		return "", errors.Wrapf(ErrNotSlashable, "%d negative votes, the contract needs %s", len(rs), quorum)
+	}
+
+	return e.submit(ctx, slashInvalidProofMethod+"/"+evidence.ID, slashInvalidProofMethod, evidence.RequestID, prover, proofHash, rs, ss, vs)
+}
+
+func (e *Ethereum) slashEquivocation(ctx context.Context, evidence common.Evidence, prover ethcommon.Address) (string, error) {
+	rs, ss, vs, err := equivocationSignatures(evidence)
+	if err != nil {
+		return "", err
+	}
+
+	return e.submit(ctx, slashEquivocationMethod+"/"+evidence.ID, slashEquivocationMethod, evidence.RequestID, prover, ethcommon.HexToHash(evidence.ProofHash), rs, ss, vs)
+}
+
+// signedProofSignatures puts the consumer's signature first, then go the validators' ones sorted by the validator's
+// address, the contract rejects duplicates this way
+func signedProofSignatures(proof common.SignedProof) ([][32]byte, [][32]byte, []uint8, error) {
+	if len(proof.Signatures) == 0 {
+		return nil, nil, nil, errors.New("no signatures provided")
+	}
+
+	r, s, v, err := common.GetRSV(proof.Request.Signature)
 	if err != nil {
-		return errors.Wrap(err, "error submitting signed proof")
+		return nil, nil, nil, errors.Wrap(err, "error getting RSV of the consumer's signature")
+	}
+
+	rs, ss, vs, err := sortedValidationSignatures(proof.Request.ID, proof.ProverID, proof.ProofHash, true, proof.Signatures)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return append([][32]byte{r}, rs...), append([][32]byte{s}, ss...), append([]uint8{v}, vs...), nil
+}
+
+// invalidProofSignatures sorts the negative votes by the validator's address, the contract rejects duplicates this way
+func invalidProofSignatures(evidence common.Evidence) ([][32]byte, [][32]byte, []uint8, error) {
+	signatures := make([][]byte, 0, len(evidence.Votes))
+	for _, vote := range evidence.Votes {
+		if !vote.IsValid {
+			signatures = append(signatures, vote.Signature)
+		}
 	}
 
-	receipt, err := bind.WaitMined(ctx, e.ethClient, tx)
+	return sortedValidationSignatures(evidence.RequestID, evidence.ProverID, evidence.ProofHash, false, signatures)
+}
+
+// sortedValidationSignatures orders the signatures by the validator's address, so the contract can rule out duplicates
+func sortedValidationSignatures(requestID common.RequestID, proverID peer.ID, proofHash string, isValid bool, validationSignatures [][]byte) ([][32]byte, [][32]byte, []uint8, error) {
+	hash, err := common.ValidationHash(requestID, proverID, proofHash, isValid)
 	if err != nil {
-		return errors.Wrap(err, "error waiting for the transaction to be mined")
+		return nil, nil, nil, err
+	}
+
+	type signedBy struct {
+		addr      ethcommon.Address
+		signature []byte
 	}
 
-	slog.Info("Transaction mined", "tx", receipt.TxHash.String(), "status", receipt.Status)
+	signatures := make([]signedBy, 0, len(validationSignatures))
+	for _, signature := range validationSignatures {
+		addr, err := common.RecoverAddress(hash, signature)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "error recovering the validator's address")
+		}
+
+		signatures = append(signatures, signedBy{addr: addr, signature: signature})
+	}
+
+	slices.SortFunc(signatures, func(a, b signedBy) int {
+		return bytes.Compare(a.addr.Bytes(), b.addr.Bytes())
+	})
+
+	rs := make([][32]byte, len(signatures))
+	ss := make([][32]byte, len(signatures))
+	vs := make([]uint8, len(signatures))
+	for i, s := range signatures {
+		rs[i], ss[i], vs[i], err = common.GetRSV(s.signature)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "error getting RSV of the validator's signature")
+		}
+	}
+
+	return rs, ss, vs, nil
+}
+
+// equivocationSignatures puts the positive vote first and the negative one second, as the contract expects
+func equivocationSignatures(evidence common.Evidence) ([2][32]byte, [2][32]byte, [2]uint8, error) {
+	var rs, ss [2][32]byte
+	var vs [2]uint8
+	var found [2]bool
+
+	for _, vote := range evidence.Votes {
+		i := 1
+		if vote.IsValid {
+			i = 0
+		}
+
+		if found[i] {
+			continue
+		}
+
+		r, s, v, err := common.GetRSV(vote.Signature)
+		if err != nil {
+			return rs, ss, vs, errors.Wrap(err, "error getting RSV of the validator's signature")
+		}
+
+		rs[i], ss[i], vs[i], found[i] = r, s, v, true
+	}
+
+	if !found[0] || !found[1] {
+		return rs, ss, vs, errors.New("evidence has no conflicting votes")
+	}
+
+	return rs, ss, vs, nil
+}
+
+// submit packs the call and hands it to the outbox, the calls with the same id are sent once.
+// Returns the hash of the mined transaction, a reverted transaction is an error
+func (e *Ethereum) submit(ctx context.Context, id, method string, args ...any) (string, error) {
+	data, err := e.abi.Pack(method, args...)
+	if err != nil {
+		return "", errors.Wrap(err, "error packing the call")
+	}
 
-	return nil
+	return e.outbox.Submit(ctx, id, method, data)
 }
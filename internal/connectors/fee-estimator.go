@@ -0,0 +1,128 @@
+package connectors
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+const (
+	// baseFeeMultiplier keeps the transaction includable while the base fee grows for a few full blocks
+	baseFeeMultiplier = 2
+	// feeBumpPercent is the price of a replacement transaction, the nodes require at least 110%
+	feeBumpPercent = 125
+)
+
+var ErrFeeAboveCap = errors.New("network fee is above the configured cap")
+
+// FeeEstimator sets the EIP-1559 fees of the transactions: the tip suggested by the node on top of the doubled
+// base fee, both capped by the config. The chains without the base fee get a capped legacy gas price
+type FeeEstimator struct {
+	backend bind.ContractTransactor
+	maxFee  *big.Int
+	maxTip  *big.Int
+}
+
+func NewFeeEstimator(backend bind.ContractTransactor, maxFee, maxTip *big.Int) *FeeEstimator {
+	return &FeeEstimator{
+		backend: backend,
+		maxFee:  maxFee,
+		maxTip:  maxTip,
+	}
+}
+
+// Apply fills the fee fields of opts, a transaction that can't pay the current base fee within the cap isn't sent
+func (f *FeeEstimator) Apply(ctx context.Context, opts *bind.TransactOpts) error {
+	header, err := f.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "error getting the latest header")
+	}
+
+	if header.BaseFee == nil {
+		price, err := f.backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return errors.Wrap(err, "error suggesting the gas price")
+		}
+
+		if price.Cmp(f.maxFee) > 0 {
+			return errors.Wrapf(ErrFeeAboveCap, "gas price: %s wei", price)
+		}
+		opts.GasPrice = price
+
+		return nil
+	}
+
+	if header.BaseFee.Cmp(f.maxFee) > 0 {
+		return errors.Wrapf(ErrFeeAboveCap, "base fee: %s wei", header.BaseFee)
+	}
+
+	tip, err := f.backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error suggesting the gas tip")
+	}
+	tip = bigMin(tip, f.maxTip)
+
+	feeCap := new(big.Int).Mul(header.BaseFee, big.NewInt(baseFeeMultiplier))
+	feeCap = bigMin(feeCap.Add(feeCap, tip), f.maxFee)
+
+	opts.GasTipCap = bigMin(tip, feeCap)
+	opts.GasFeeCap = feeCap
+
+	return nil
+}
+
+// Replace fills the fee fields for the replacement of a pending transaction with the given fees, the new fees are
+// the current estimation, but at least feeBumpPercent of the old ones. ErrFeeAboveCap means the cap leaves no room for the bump
+func (f *FeeEstimator) Replace(ctx context.Context, opts *bind.TransactOpts, gasPrice, tipCap, feeCap *big.Int) error {
+	if err := f.Apply(ctx, opts); err != nil {
+		return err
+	}
+
+	if opts.GasPrice != nil {
+		if gasPrice == nil {
+			return nil
+		}
+
+		opts.GasPrice = bigMax(opts.GasPrice, bump(gasPrice))
+		if opts.GasPrice.Cmp(f.maxFee) > 0 {
+			return errors.Wrapf(ErrFeeAboveCap, "bumped gas price: %s wei", opts.GasPrice)
+		}
+
+		return nil
+	}
+
+	if tipCap == nil || feeCap == nil {
+		return nil
+	}
+
+	opts.GasFeeCap = bigMax(opts.GasFeeCap, bump(feeCap))
+	if opts.GasFeeCap.Cmp(f.maxFee) > 0 {
+		return errors.Wrapf(ErrFeeAboveCap, "bumped fee: %s wei", opts.GasFeeCap)
+	}
+	opts.GasTipCap = bigMin(bigMax(opts.GasTipCap, bump(tipCap)), opts.GasFeeCap)
+
+	return nil
+}
+
+func bump(fee *big.Int) *big.Int {
+	res := new(big.Int).Mul(fee, big.NewInt(feeBumpPercent))
+
+	return res.Div(res, big.NewInt(100))
+}
+
+func bigMax(a, b *big.Int) *big.Int {
+	if a.Cmp(b) > 0 {
+		return a
+	}
+
+	return b
+}
+
+func bigMin(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,109 @@
+package connectors
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+)
+
+func TestFeeEstimatorApply(t *testing.T) {
+	chain := newSimulatedChain(t)
+	ctx := context.Background()
+
+	header, err := chain.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseFee := header.BaseFee
+	doubled := new(big.Int).Mul(baseFee, big.NewInt(2))
+
+	tests := []struct {
+		name    string
+		maxFee  *big.Int
+		maxTip  *big.Int
+		wantFee *big.Int
+		wantTip *big.Int
+		wantErr error
+	}{
+		{
+			name:    "under the caps",
+			maxFee:  new(big.Int).Mul(baseFee, big.NewInt(10)),
+			maxTip:  big.NewInt(100),
+			wantFee: new(big.Int).Add(doubled, big.NewInt(1)),
+			wantTip: big.NewInt(1), // the simulated backend suggests 1 wei
+		},
+		{
+			name:    "fee clamped to the cap",
+			maxFee:  new(big.Int).Add(baseFee, big.NewInt(1)),
+			maxTip:  big.NewInt(100),
+			wantFee: new(big.Int).Add(baseFee, big.NewInt(1)),
+			wantTip: big.NewInt(1),
+		},
+		{
+			name:    "tip clamped to the cap",
+			maxFee:  new(big.Int).Mul(baseFee, big.NewInt(10)),
+			maxTip:  big.NewInt(0),
+			wantFee: doubled,
+			wantTip: big.NewInt(0),
+		},
+		{
+			name:    "base fee above the cap",
+			maxFee:  new(big.Int).Sub(baseFee, big.NewInt(1)),
+			maxTip:  big.NewInt(100),
+			wantErr: ErrFeeAboveCap,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &bind.TransactOpts{}
+			err := NewFeeEstimator(chain.backend, tt.maxFee, tt.maxTip).Apply(ctx, opts)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error is %v, want %v", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if opts.GasFeeCap.Cmp(tt.wantFee) != 0 || opts.GasTipCap.Cmp(tt.wantTip) != 0 {
+				t.Fatalf("fees are %s/%s, want %s/%s", opts.GasFeeCap, opts.GasTipCap, tt.wantFee, tt.wantTip)
+			}
+		})
+	}
+}
+
+func TestFeeEstimatorReplace(t *testing.T) {
+	chain := newSimulatedChain(t)
+	ctx := context.Background()
+
+	header, err := chain.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldFee := new(big.Int).Mul(header.BaseFee, big.NewInt(4))
+	oldTip := big.NewInt(1000)
+
+	fees := NewFeeEstimator(chain.backend, new(big.Int).Mul(header.BaseFee, big.NewInt(10)), big.NewInt(10_000))
+	opts := &bind.TransactOpts{}
+	if err := fees.Replace(ctx, opts, nil, oldTip, oldFee); err != nil {
+		t.Fatal(err)
+	}
+
+	if opts.GasFeeCap.Cmp(bump(oldFee)) != 0 || opts.GasTipCap.Cmp(bump(oldTip)) != 0 {
+		t.Fatalf("replacement fees are %s/%s, want %s/%s", opts.GasFeeCap, opts.GasTipCap, bump(oldFee), bump(oldTip))
+	}
+
+	// the cap leaves no room for the bump
+	capped := NewFeeEstimator(chain.backend, oldFee, big.NewInt(10_000))
+	if err := capped.Replace(ctx, &bind.TransactOpts{}, nil, oldTip, oldFee); !errors.Is(err, ErrFeeAboveCap) {
+		t.Fatalf("error is %v, want %v", err, ErrFeeAboveCap)
+	}
+}
@@ -0,0 +1,69 @@
+package connectors
+
+import (
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+type LevelDB struct {
+	db *leveldb.DB
+}
+
+func NewLevelDB(path string) (*LevelDB, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening leveldb")
+	}
+
+	return &LevelDB{
+		db: db,
+	}, nil
+}
+
+func (l *LevelDB) Get(key []byte) ([]byte, error) {
+	value, err := l.db.Get(key, nil)
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nil, ErrKeyNotFound
+		}
+
+		return nil, errors.Wrap(err, "error reading from leveldb")
+	}
+
+	return value, nil
+}
+
+func (l *LevelDB) Has(key []byte) (bool, error) {
+	ok, err := l.db.Has(key, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "error reading from leveldb")
+	}
+
+	return ok, nil
+}
+
+func (l *LevelDB) Put(key, value []byte) error {
+	return errors.Wrap(l.db.Put(key, value, nil), "error writing to leveldb")
+}
+
+func (l *LevelDB) Delete(key []byte) error {
+	return errors.Wrap(l.db.Delete(key, nil), "error deleting from leveldb")
+}
+
+func (l *LevelDB) Iterate(prefix []byte, fn func(key, value []byte) error) error {
+	iter := l.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		if err := fn(iter.Key(), iter.Value()); err != nil {
+			return err
+		}
+	}
+
+	return errors.Wrap(iter.Error(), "error iterating over leveldb")
+}
+
+func (l *LevelDB) Close() error {
+	return l.db.Close()
+}
@@ -0,0 +1,88 @@
+package connectors
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// MemoryStorage keeps everything in RAM, it's meant to be used for testing only
+type MemoryStorage struct {
+	m  map[string][]byte
+	mu sync.RWMutex
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		m: make(map[string][]byte),
+	}
+}
+
+func (s *MemoryStorage) Get(key []byte) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	value, ok := s.m[string(key)]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+
+	return bytes.Clone(value), nil
+}
+
+func (s *MemoryStorage) Has(key []byte) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.m[string(key)]
+
+	return ok, nil
+}
+
+func (s *MemoryStorage) Put(key, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m[string(key)] = bytes.Clone(value)
+
+	return nil
+}
+
+func (s *MemoryStorage) Delete(key []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.m, string(key))
+
+	return nil
+}
+
+func (s *MemoryStorage) Iterate(prefix []byte, fn func(key, value []byte) error) error {
+	s.mu.RLock()
+	keys := make([]string, 0)
+	for k := range s.m {
+		if strings.HasPrefix(k, string(prefix)) {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	values := make([][]byte, len(keys))
+	for i, k := range keys {
+		values[i] = s.m[k]
+	}
+	s.mu.RUnlock()
+
+	for i, k := range keys {
+		if err := fn([]byte(k), bytes.Clone(values[i])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *MemoryStorage) Close() error {
+	return nil
+}
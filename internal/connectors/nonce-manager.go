@@ -0,0 +1,65 @@
+package connectors
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"sync"
+)
+
+// NonceManager hands out the nonces of the node's account locally, so the concurrent submissions from the different
+// requests don't reuse the pending nonce of the node. The nonce is read from the node again after a failed send,
+// since the failure may come from a nonce that went out of sync
+type NonceManager struct {
+	backend bind.ContractTransactor
+	address ethcommon.Address
+
+	next   uint64
+	min    uint64 // the nonces below are taken by the transactions the node may not know about
+	synced bool
+	mu     sync.Mutex
+}
+
+func NewNonceManager(backend bind.ContractTransactor, address ethcommon.Address) *NonceManager {
+	return &NonceManager{
+		backend: backend,
+		address: address,
+	}
+}
+
+// Reserve keeps the nonce from being handed out, for the signed transactions that weren't accepted by the node yet
+func (m *NonceManager) Reserve(nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.min = max(m.min, nonce+1)
+	if m.synced {
+		m.next = max(m.next, m.min)
+	}
+}
+
+// Send calls send with the next nonce, the sends are serialized and the nonce is only consumed when send succeeds
+func (m *NonceManager) Send(ctx context.Context, send func(nonce uint64) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.synced {
+		nonce, err := m.backend.PendingNonceAt(ctx, m.address)
+		if err != nil {
+			return errors.Wrap(err, "error getting the pending nonce")
+		}
+
+		m.next = max(nonce, m.min)
+		m.synced = true
+	}
+
+	if err := send(m.next); err != nil {
+		m.synced = false
+
+		return err
+	}
+	m.next++
+
+	return nil
+}
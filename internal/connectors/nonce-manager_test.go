@@ -0,0 +1,108 @@
+package connectors
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestNonceManagerConcurrentSends(t *testing.T) {
+	chain := newSimulatedChain(t)
+	nonces := NewNonceManager(chain.backend, chain.transactor.From)
+
+	const senders = 20
+	var mu sync.Mutex
+	var sent []uint64
+	var wg sync.WaitGroup
+	for i := 0; i < senders; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := nonces.Send(context.Background(), func(nonce uint64) error {
+				if err := chain.backend.SendTransaction(context.Background(), chain.transfer(t, nonce)); err != nil {
+					return err
+				}
+
+				mu.Lock()
+				sent = append(sent, nonce)
+				mu.Unlock()
+
+				return nil
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	slices.Sort(sent)
+	for i, nonce := range sent {
+		if nonce != uint64(i) {
+			t.Fatalf("nonces have a gap or a duplicate: %v", sent)
+		}
+	}
+
+	if len(sent) != senders {
+		t.Fatalf("sent %d transactions, want %d", len(sent), senders)
+	}
+}
+
+func TestNonceManagerResyncsAfterFailedSend(t *testing.T) {
+	chain := newSimulatedChain(t)
+	nonces := NewNonceManager(chain.backend, chain.transactor.From)
+	ctx := context.Background()
+
+	send := func(nonce uint64) error {
+		return chain.backend.SendTransaction(ctx, chain.transfer(t, nonce))
+	}
+
+	if err := nonces.Send(ctx, send); err != nil {
+		t.Fatal(err)
+	}
+
+	// another client of the same account takes the next nonce behind the manager's back
+	if err := chain.backend.SendTransaction(ctx, chain.transfer(t, 1)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := nonces.Send(ctx, send); err == nil {
+		t.Fatal("the send with the taken nonce succeeded")
+	}
+
+	var got uint64
+	err := nonces.Send(ctx, func(nonce uint64) error {
+		got = nonce
+
+		return send(nonce)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != 2 {
+		t.Fatalf("nonce after the resync is %d, want 2", got)
+	}
+}
+
+func TestNonceManagerSkipsReservedNonces(t *testing.T) {
+	chain := newSimulatedChain(t)
+	nonces := NewNonceManager(chain.backend, chain.transactor.From)
+	nonces.Reserve(4)
+
+	var got uint64
+	err := nonces.Send(context.Background(), func(nonce uint64) error {
+		got = nonce
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != 5 {
+		t.Fatalf("nonce after the reserved one is %d, want 5", got)
+	}
+}
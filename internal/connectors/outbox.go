@@ -0,0 +1,482 @@
+package connectors
+
+import (
+	"cmp"
+	"context"
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/metrics"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"log/slog"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	outboxPrefix    = "outbox/"
+	outboxRetention = 7 * 24 * time.Hour
+	transferGas     = 21000
+)
+
+var (
+	ErrTxReverted = errors.New("transaction reverted")
+	ErrCallFailed = errors.New("outbox gave up on the call")
+)
+
+type outboxResult struct {
+	txHash string
+	err    error
+}
+
+// Outbox persists every contract call before it's sent and keeps it until the transaction is mined. The calls that
+// failed on the RPC errors are sent again, the transactions pending for too long are replaced with bumped fees,
+// and the unfinished calls are resumed after a restart. A single loop sends everything, the callers only wait
+type Outbox struct {
+	backend  EthBackend
+	contract *bind.BoundContract
+	storage  StorageBackend
+	address  ethcommon.Address
+	signer   bind.SignerFn
+	nonces   *NonceManager
+	fees     *FeeEstimator
+
+	pollInterval time.Duration
+	replaceAfter time.Duration
+	maxAttempts  int
+
+	entries map[string]common.OutboxEntry
+	waiters map[string][]chan outboxResult
+	kick    chan struct{}
+	mu      sync.Mutex
+}
+
+func NewOutbox(cfg *common.Config, storage StorageBackend, backend EthBackend, contract *bind.BoundContract, transactor *bind.TransactOpts) (*Outbox, error) {
+	o := &Outbox{
+		backend:      backend,
+		contract:     contract,
+		storage:      storage,
+		address:      transactor.From,
+		signer:       transactor.Signer,
+		nonces:       NewNonceManager(backend, transactor.From),
+		fees:         NewFeeEstimator(backend, cfg.MaxFeePerGasWei(), cfg.MaxPriorityFeePerGasWei()),
+		pollInterval: cfg.OutboxPollInterval,
+		replaceAfter: cfg.OutboxReplaceAfter,
+		maxAttempts:  cfg.OutboxMaxAttempts,
+		entries:      make(map[string]common.OutboxEntry),
+		waiters:      make(map[string][]chan outboxResult),
+		kick:         make(chan struct{}, 1),
+	}
+
+	err := storage.Iterate([]byte(outboxPrefix), func(_, value []byte) error {
+		var entry common.OutboxEntry
+		if err := common.GobDecodeMessage(value, &entry); err != nil {
+			return err
+		}
+
+		o.entries[entry.ID] = entry
+
+		// the node could lose the signed transaction, its nonce is still taken
+		if (!entry.State.IsFinal() && entry.HasNonce) || entry.FillNonce {
+			o.nonces.Reserve(entry.Nonce)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading the outbox")
+	}
+
+	return o, nil
+}
+
+// Submit queues the call and waits for its transaction to be mined, the call with the same ID is only mined once,
+// the reverted and the given up ones are queued again. The call stays in the outbox when ctx is done
+func (o *Outbox) Submit(ctx context.Context, id, method string, data []byte) (string, error) {
+	o.mu.Lock()
+	entry, ok := o.entries[id]
+	if ok && entry.State == common.OutboxMined {
+		o.mu.Unlock()
+
+		return result(entry).txHash, result(entry).err
+	}
+
+	if !ok || entry.State.IsFinal() {
+		retry := common.OutboxEntry{
+			ID:        id,
+			Method:    method,
+			Data:      data,
+			State:     common.OutboxQueued,
+			CreatedAt: time.Now().UnixNano(),
+		}
+
+		// the nonce the given up call left unused goes to the retry instead of a self-transfer
+		if ok && entry.FillNonce {
+			retry.HasNonce, retry.Nonce = true, entry.Nonce
+		}
+		entry = retry
+
+		if err := o.put(entry); err != nil {
+			o.mu.Unlock()
+
+			return "", errors.Wrap(err, "error saving the outbox entry")
+		}
+	}
+
+	ch := make(chan outboxResult, 1)
+	o.waiters[id] = append(o.waiters[id], ch)
+	o.mu.Unlock()
+
+	select {
+	case o.kick <- struct{}{}:
+	default:
+	}
+
+	select {
+	case res := <-ch:
+		return res.txHash, res.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// List returns all the entries kept by the outbox, the oldest first
+func (o *Outbox) List() []common.OutboxEntry {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	res := make([]common.OutboxEntry, 0, len(o.entries))
+	for _, entry := range o.entries {
+		res = append(res, entry)
+	}
+	slices.SortFunc(res, func(a, b common.OutboxEntry) int {
+		return cmp.Compare(a.CreatedAt, b.CreatedAt)
+	})
+
+	return res
+}
+
+func (o *Outbox) Run(ctx context.Context) {
+	ticker := time.NewTicker(o.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		o.process(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-o.kick:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (o *Outbox) process(ctx context.Context) {
+	for _, entry := range o.List() {
+		if entry.FillNonce {
+			if err := o.fillNonce(ctx, &entry); err != nil {
+				slog.Warn("outbox nonce isn't filled yet", slog.String("id", entry.ID), slog.Uint64("nonce", entry.Nonce), slog.String("err", err.Error()))
+			}
+
+			continue
+		}
+
+		if entry.State.IsFinal() {
+			if time.Since(finishedAt(entry)) > outboxRetention {
+				o.forget(entry.ID)
+			}
+
+			continue
+		}
+
+		var err error
+		switch entry.State {
+		case common.OutboxQueued:
+			err = o.send(ctx, &entry)
+		case common.OutboxSent:
+			err = o.check(ctx, &entry)
+		}
+
+		if err != nil {
+			slog.Warn("outbox call isn't sent yet", slog.String("id", entry.ID), slog.String("err", err.Error()))
+			entry.LastError = err.Error()
+
+			// the RPC errors are retried until the node is back, the calls that keep reverting are given up. A sent
+			// transaction holds its nonce until it's mined or replaced, so it's waited for even if its replacement reverts
+			if isRevert(err) && entry.State == common.OutboxQueued {
+				entry.Attempts++
+				if entry.Attempts >= o.maxAttempts {
+					entry.State = common.OutboxFailed
+					// the transaction signed before was never accepted, the later ones would wait for its nonce forever
+					entry.FillNonce = entry.HasNonce
+					metrics.EthereumSubmissions.WithLabelValues(entry.Method, metrics.OutcomeFailed).Inc()
+				}
+			}
+		}
+
+		if err := o.update(entry); err != nil {
+			slog.Error("error saving the outbox entry", slog.String("id", entry.ID), slog.String("err", err.Error()))
+		}
+	}
+}
+
+// send signs the transaction with a new nonce, or with the taken one if the node never accepted the transaction
+func (o *Outbox) send(ctx context.Context, entry *common.OutboxEntry) error {
+	var tx *types.Transaction
+	var err error
+	if entry.HasNonce {
+		tx, err = o.sign(ctx, entry, entry.Nonce, false)
+	} else {
+		err = o.nonces.Send(ctx, func(nonce uint64) error {
+			tx, err = o.sign(ctx, entry, nonce, false)
+
+			return err
+		})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return o.broadcast(ctx, entry, tx)
+}
+
+// check finishes the entry once any version of the transaction is mined, and replaces the transaction that is pending for too long
+func (o *Outbox) check(ctx context.Context, entry *common.OutboxEntry) error {
+	mined, err := o.findReceipt(ctx, entry)
+	if err != nil || mined {
+		return err
+	}
+
+	if time.Since(time.Unix(0, entry.SentAt)) < o.replaceAfter {
+		return nil
+	}
+
+	tx, err := o.sign(ctx, entry, entry.Nonce, true)
+	if errors.Is(err, ErrFeeAboveCap) {
+		// the transaction can't be priced higher, it waits for the base fee to go down
+		slog.Warn("can't bump the fees of a stuck transaction", slog.String("id", entry.ID), slog.String("err", err.Error()))
+		entry.SentAt = time.Now().UnixNano()
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	slog.Info("replacing a stuck transaction", slog.String("id", entry.ID), slog.String("tx", tx.Hash().Hex()))
+	metrics.EthereumSubmissions.WithLabelValues(entry.Method, metrics.OutcomeReplaced).Inc()
+
+	return o.broadcast(ctx, entry, tx)
+}
+
+// sign saves the signed transaction before it's broadcast, so it's looked up after a restart
+func (o *Outbox) sign(ctx context.Context, entry *common.OutboxEntry, nonce uint64, replace bool) (*types.Transaction, error) {
+	opts := &bind.TransactOpts{
+		Context: ctx,
+		From:    o.address,
+		Signer:  o.signer,
+		Nonce:   new(big.Int).SetUint64(nonce),
+		NoSend:  true,
+	}
+
+	var err error
+	if replace {
+		err = o.fees.Replace(ctx, opts, entry.GasPrice, entry.GasTipCap, entry.GasFeeCap)
+	} else {
+		err = o.fees.Apply(ctx, opts)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "error estimating the fees")
+	}
+
+	tx, err := o.contract.RawTransact(opts, entry.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "error signing the transaction")
+	}
+
+	entry.HasNonce = true
+	entry.Nonce = nonce
+	entry.GasPrice, entry.GasTipCap, entry.GasFeeCap = opts.GasPrice, opts.GasTipCap, opts.GasFeeCap
+	if !slices.Contains(entry.TxHashes, tx.Hash().Hex()) {
+		entry.TxHashes = append(entry.TxHashes, tx.Hash().Hex())
+	}
+	entry.SentAt = time.Now().UnixNano()
+
+	return tx, o.update(*entry)
+}
+
+func (o *Outbox) broadcast(ctx context.Context, entry *common.OutboxEntry, tx *types.Transaction) error {
+	err := o.backend.SendTransaction(ctx, tx)
+	if err == nil || strings.Contains(err.Error(), "already known") {
+		entry.State = common.OutboxSent
+		entry.LastError = ""
+
+		return nil
+	}
+
+	if !strings.Contains(err.Error(), "nonce too low") {
+		return errors.Wrap(err, "error sending the transaction")
+	}
+
+	// the nonce is used either by an earlier version of the transaction or by another transaction of the account
+	mined, rerr := o.findReceipt(ctx, entry)
+	if rerr != nil || mined {
+		return rerr
+	}
+
+	slog.Warn("transaction nonce is taken, signing with a new one", slog.String("id", entry.ID), slog.Uint64("nonce", entry.Nonce))
+	entry.HasNonce = false
+	entry.State = common.OutboxQueued
+
+	return errors.Wrap(err, "error sending the transaction")
+}
+
+// fillNonce sends a zero-value transfer to the node's own address with the nonce of the given up call
+func (o *Outbox) fillNonce(ctx context.Context, entry *common.OutboxEntry) error {
+	opts := &bind.TransactOpts{
+		Context:  ctx,
+		From:     o.address,
+		Signer:   o.signer,
+		Nonce:    new(big.Int).SetUint64(entry.Nonce),
+		GasLimit: transferGas,
+		NoSend:   true,
+	}
+	if err := o.fees.Apply(ctx, opts); err != nil {
+		return errors.Wrap(err, "error estimating the fees")
+	}
+
+	self := bind.NewBoundContract(o.address, abi.ABI{}, o.backend, o.backend, o.backend)
+	tx, err := self.Transfer(opts)
+	if err != nil {
+		return errors.Wrap(err, "error signing the transfer")
+	}
+
+	// a lower nonce or an underpriced replacement means the signed call got through after all and takes the nonce
+	err = o.backend.SendTransaction(ctx, tx)
+	if err != nil && !strings.Contains(err.Error(), "already known") && !strings.Contains(err.Error(), "nonce too low") &&
+		!strings.Contains(err.Error(), "underpriced") {
+		return errors.Wrap(err, "error sending the transfer")
+	}
+
+	slog.Info("filled the nonce of a given up call", slog.String("id", entry.ID), slog.Uint64("nonce", entry.Nonce), slog.String("tx", tx.Hash().Hex()))
+	entry.FillNonce = false
+
+	return o.update(*entry)
+}
+
+func (o *Outbox) findReceipt(ctx context.Context, entry *common.OutboxEntry) (bool, error) {
+	for _, hash := range entry.TxHashes {
+		receipt, err := o.backend.TransactionReceipt(ctx, ethcommon.HexToHash(hash))
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+
+		if err != nil {
+			return false, errors.Wrap(err, "error getting the transaction receipt")
+		}
+
+		entry.MinedTx = receipt.TxHash.Hex()
+		metrics.EthereumGasUsed.WithLabelValues(entry.Method).Observe(float64(receipt.GasUsed))
+		slog.Info("Transaction mined", "tx", entry.MinedTx, "status", receipt.Status)
+
+		if receipt.Status == types.ReceiptStatusSuccessful {
+			entry.State = common.OutboxMined
+			metrics.EthereumSubmissions.WithLabelValues(entry.Method, metrics.OutcomeMined).Inc()
+		} else {
+			entry.State = common.OutboxReverted
+			metrics.EthereumSubmissions.WithLabelValues(entry.Method, metrics.OutcomeReverted).Inc()
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// update saves the entry and wakes up the callers once it's finished
+func (o *Outbox) update(entry common.OutboxEntry) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if current, ok := o.entries[entry.ID]; ok && current.CreatedAt != entry.CreatedAt {
+		// the call was queued again while the loop was processing its previous run
+		return nil
+	}
+
+	if entry.State.IsFinal() && entry.FinishedAt == 0 {
+		entry.FinishedAt = time.Now().UnixNano()
+	}
+
+	if err := o.put(entry); err != nil {
+		return err
+	}
+
+	if entry.State.IsFinal() {
+		for _, ch := range o.waiters[entry.ID] {
+			ch <- result(entry)
+		}
+		delete(o.waiters, entry.ID)
+	}
+
+	return nil
+}
+
+func (o *Outbox) put(entry common.OutboxEntry) error {
+	b, err := common.GobEncodeMessage(entry)
+	if err != nil {
+		return errors.Wrap(err, "error encoding the outbox entry")
+	}
+
+	if err := o.storage.Put([]byte(outboxPrefix+entry.ID), b); err != nil {
+		return err
+	}
+	o.entries[entry.ID] = entry
+
+	return nil
+}
+
+func (o *Outbox) forget(id string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if err := o.storage.Delete([]byte(outboxPrefix + id)); err != nil {
+		slog.Error("error deleting the outbox entry", slog.String("id", id), slog.String("err", err.Error()))
+
+		return
+	}
+	delete(o.entries, id)
+}
+
+// finishedAt falls back to the creation time for the entries finished before the time was recorded
+func finishedAt(entry common.OutboxEntry) time.Time {
+	if entry.FinishedAt == 0 {
+		return time.Unix(0, entry.CreatedAt)
+	}
+
+	return time.Unix(0, entry.FinishedAt)
+}
+
+func result(entry common.OutboxEntry) outboxResult {
+	switch entry.State {
+	case common.OutboxMined:
+		return outboxResult{txHash: entry.MinedTx}
+	case common.OutboxReverted:
+		return outboxResult{err: errors.Wrapf(ErrTxReverted, "tx %s", entry.MinedTx)}
+	default:
+		return outboxResult{err: errors.Wrap(ErrCallFailed, entry.LastError)}
+	}
+}
+
+// isRevert reports whether the gas estimation failed because the call reverts
+func isRevert(err error) bool {
+	return strings.Contains(err.Error(), "execution reverted")
+}
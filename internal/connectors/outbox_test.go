@@ -0,0 +1,132 @@
+package connectors
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// droppingBackend loses the first transaction sent through it, like a node that accepts it and never gossips it
+type droppingBackend struct {
+	EthBackend
+	once    sync.Once
+	dropped *types.Transaction
+}
+
+func (b *droppingBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	drop := false
+	b.once.Do(func() {
+		drop = true
+		b.dropped = tx
+	})
+
+	if drop {
+		return nil
+	}
+
+	return b.EthBackend.SendTransaction(ctx, tx)
+}
+
+func newTestOutbox(t *testing.T, chain *simulatedChain, backend EthBackend) *Outbox {
+	t.Helper()
+
+	addr := chain.deployStub(t)
+	contract := bind.NewBoundContract(addr, abi.ABI{}, backend, backend, backend)
+
+	outbox, err := NewOutbox(testConfig(t), NewMemoryStorage(), backend, contract, chain.transactor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return outbox
+}
+
+func runChain(t *testing.T, chain *simulatedChain, outbox *Outbox) context.Context {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	go chain.mine(ctx, 20*time.Millisecond)
+	go outbox.Run(ctx)
+
+	return ctx
+}
+
+func findEntry(t *testing.T, outbox *Outbox, id string) common.OutboxEntry {
+	t.Helper()
+
+	for _, entry := range outbox.List() {
+		if entry.ID == id {
+			return entry
+		}
+	}
+	t.Fatalf("entry %s isn't in the outbox", id)
+
+	return common.OutboxEntry{}
+}
+
+func TestOutboxSubmit(t *testing.T) {
+	chain := newSimulatedChain(t)
+	outbox := newTestOutbox(t, chain, chain.backend)
+	ctx := runChain(t, chain, outbox)
+
+	txHash, err := outbox.Submit(ctx, "call", "method", []byte{0x01})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := findEntry(t, outbox, "call")
+	if entry.State != common.OutboxMined || entry.MinedTx != txHash {
+		t.Fatalf("entry is %s with %s mined, want %s with %s", entry.State, entry.MinedTx, common.OutboxMined, txHash)
+	}
+
+	// the mined call isn't sent again
+	again, err := outbox.Submit(ctx, "call", "method", []byte{0x01})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if again != txHash {
+		t.Fatalf("resubmitted call is mined in %s, want %s", again, txHash)
+	}
+}
+
+func TestOutboxReplacesStuckTransaction(t *testing.T) {
+	chain := newSimulatedChain(t)
+	backend := &droppingBackend{EthBackend: chain.backend}
+	outbox := newTestOutbox(t, chain, backend)
+	ctx := runChain(t, chain, outbox)
+
+	txHash, err := outbox.Submit(ctx, "call", "method", []byte{0x01})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if backend.dropped == nil {
+		t.Fatal("no transaction was dropped")
+	}
+
+	entry := findEntry(t, outbox, "call")
+	if len(entry.TxHashes) < 2 || entry.TxHashes[0] != backend.dropped.Hash().Hex() {
+		t.Fatalf("transactions are %v, want the dropped %s and its replacement", entry.TxHashes, backend.dropped.Hash().Hex())
+	}
+
+	if txHash == backend.dropped.Hash().Hex() || entry.MinedTx != txHash {
+		t.Fatalf("mined transaction is %s, want a replacement of %s", txHash, backend.dropped.Hash().Hex())
+	}
+
+	if entry.Nonce != backend.dropped.Nonce() {
+		t.Fatalf("replacement nonce is %d, want %d", entry.Nonce, backend.dropped.Nonce())
+	}
+
+	if entry.GasFeeCap.Cmp(bump(backend.dropped.GasFeeCap())) < 0 {
+		t.Fatalf("replacement fee cap is %s, want at least %s", entry.GasFeeCap, bump(backend.dropped.GasFeeCap()))
+	}
+}
@@ -4,20 +4,32 @@ import (
 	"context"
 	"github.com/dimazhornyk/generic-proving-network/internal/common"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 )
 
+// signPrefix is prepended to the message by libp2p before signing it
+const signPrefix = "libp2p-pubsub:"
+
 type PubSub struct {
-	ps            *pubsub.PubSub
-	globalTopic   *pubsub.Topic
-	requestsTopic *pubsub.Topic
-	votingTopic   *pubsub.Topic
-	proofsTopic   *pubsub.Topic
+	hostID          peer.ID
+	ps              *pubsub.PubSub
+	globalTopic     *pubsub.Topic
+	requestsTopic   *pubsub.Topic
+	votingTopic     *pubsub.Topic
+	proofsTopic     *pubsub.Topic
+	randomnessTopic *pubsub.Topic
 }
 
 func NewPubSub(ctx context.Context, host host.Host) (*PubSub, error) {
-	gossipSub, err := pubsub.NewGossipSub(ctx, host)
+	// the handlers rely on the signed From field to attribute messages to their authors
+	gossipSub, err := pubsub.NewGossipSub(ctx, host,
+		pubsub.WithMessageSigning(true),
+		pubsub.WithStrictSignatureVerification(true),
+	)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating a new gossip sub")
 	}
@@ -42,17 +54,24 @@ func NewPubSub(ctx context.Context, host host.Host) (*PubSub, error) {
 		return nil, errors.Wrap(err, "error joining a proofs topic")
 	}
 
+	randomnessTopic, err := gossipSub.Join(common.RandomnessTopic.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "error joining a randomness topic")
+	}
+
 	return &PubSub{
-		ps:            gossipSub,
-		globalTopic:   globalTopic,
-		requestsTopic: requestsTopic,
-		votingTopic:   votingTopic,
-		proofsTopic:   proofsTopic,
+		hostID:          host.ID(),
+		ps:              gossipSub,
+		globalTopic:     globalTopic,
+		requestsTopic:   requestsTopic,
+		votingTopic:     votingTopic,
+		proofsTopic:     proofsTopic,
+		randomnessTopic: randomnessTopic,
 	}, nil
 }
 
 func (p *PubSub) SendStatusMessage(ctx context.Context, msg common.StatusMessage) error {
-	b, err := common.GobEncodeMessage(msg)
+	b, err := encodeMessage(ctx, p.hostID, msg)
 	if err != nil {
 		return errors.Wrap(err, "error encoding a status message")
 	}
@@ -65,7 +84,7 @@ func (p *PubSub) SendStatusMessage(ctx context.Context, msg common.StatusMessage
 }
 
 func (p *PubSub) Publish(ctx context.Context, topic common.Topic, msg any) error {
-	b, err := common.GobEncodeMessage(msg)
+	b, err := encodeMessage(ctx, p.hostID, msg)
 	if err != nil {
 		return errors.Wrap(err, "error encoding a message")
 	}
@@ -97,7 +116,58 @@ func (p *PubSub) selectTopic(topic common.Topic) (*pubsub.Topic, error) {
 		return p.votingTopic, nil
 	case common.ProofsTopic:
 		return p.proofsTopic, nil
+	case common.RandomnessTopic:
+		return p.randomnessTopic, nil
 	default:
 		return nil, errors.New("unknown topic")
 	}
 }
+
+// VerifySignedMessage checks the libp2p signature of a marshaled pubsub message and returns its author and data,
+// so the messages kept as evidence can be verified by anyone
+func VerifySignedMessage(raw []byte) (peer.ID, []byte, error) {
+	var msg pb.Message
+	if err := msg.Unmarshal(raw); err != nil {
+		return "", nil, errors.Wrap(err, "error decoding the message")
+	}
+
+	author, err := peer.IDFromBytes(msg.GetFrom())
+	if err != nil {
+		return "", nil, errors.Wrap(err, "error decoding the author")
+	}
+
+	pubKey, err := author.ExtractPublicKey()
+	if err != nil {
+		if len(msg.GetKey()) == 0 {
+			return "", nil, errors.Wrap(err, "no public key of the author")
+		}
+
+		pubKey, err = crypto.UnmarshalPublicKey(msg.GetKey())
+		if err != nil {
+			return "", nil, errors.Wrap(err, "error decoding the public key")
+		}
+
+		if !author.MatchesPublicKey(pubKey) {
+			return "", nil, errors.New("public key doesn't match the author")
+		}
+	}
+
+	unsigned := msg
+	unsigned.Signature = nil
+	unsigned.Key = nil
+	b, err := unsigned.Marshal()
+	if err != nil {
+		return "", nil, errors.Wrap(err, "error encoding the message")
+	}
+
+	ok, err := pubKey.Verify(append([]byte(signPrefix), b...), msg.GetSignature())
+	if err != nil {
+		return "", nil, errors.Wrap(err, "error verifying the signature")
+	}
+
+	if !ok {
+		return "", nil, errors.New("invalid message signature")
+	}
+
+	return author, msg.GetData(), nil
+}
@@ -0,0 +1,127 @@
+package connectors
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// simulatedChainID is the chain ID of go-ethereum's simulated backend
+var simulatedChainID = big.NewInt(1337)
+
+// stubContractCode deploys a contract whose code is a single STOP, so every call to it succeeds
+var stubContractCode = ethcommon.FromHex("0x6001600c60003960016000f300")
+
+type simulatedChain struct {
+	backend    *backends.SimulatedBackend
+	key        *ecdsa.PrivateKey
+	transactor *bind.TransactOpts
+}
+
+func newSimulatedChain(t *testing.T) *simulatedChain {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transactor, err := bind.NewKeyedTransactorWithChainID(key, simulatedChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		transactor.From: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))},
+	}, 30_000_000)
+	t.Cleanup(func() {
+		_ = backend.Close()
+	})
+
+	return &simulatedChain{
+		backend:    backend,
+		key:        key,
+		transactor: transactor,
+	}
+}
+
+// deployStub deploys the stub contract and mines it
+func (c *simulatedChain) deployStub(t *testing.T) ethcommon.Address {
+	t.Helper()
+
+	addr, _, _, err := bind.DeployContract(c.transactor, abi.ABI{}, stubContractCode, c.backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.backend.Commit()
+
+	return addr
+}
+
+// mine commits the pending transactions until ctx is done, like a chain producing blocks
+func (c *simulatedChain) mine(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.backend.Commit()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// transfer signs a zero-value transfer to the account itself with the given nonce
+func (c *simulatedChain) transfer(t *testing.T, nonce uint64) *types.Transaction {
+	t.Helper()
+
+	header, err := c.backend.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feeCap := new(big.Int).Add(new(big.Int).Mul(header.BaseFee, big.NewInt(2)), big.NewInt(1))
+	tx, err := types.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   simulatedChainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: feeCap,
+		Gas:       transferGas,
+		To:        &c.transactor.From,
+	}), types.LatestSignerForChainID(simulatedChainID), c.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tx
+}
+
+// testConfig reads the config from the environment like the node does, with the outbox timings shortened
+func testConfig(t *testing.T) *common.Config {
+	t.Helper()
+
+	t.Setenv("ETHEREUM_API", "http://localhost:8545")
+	t.Setenv("OUTBOX_POLL_INTERVAL", "10ms")
+	t.Setenv("OUTBOX_REPLACE_AFTER", "200ms")
+
+	cfg, err := common.NewConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cfg
+}
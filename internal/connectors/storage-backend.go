@@ -0,0 +1,36 @@
+package connectors
+
+import (
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	LevelDBBackend = "leveldb"
+	MemoryBackend  = "memory"
+)
+
+var ErrKeyNotFound = errors.New("key not found")
+
+// StorageBackend is a key-value store used to persist the node's state
+type StorageBackend interface {
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) (bool, error)
+	Put(key, value []byte) error
+	Delete(key []byte) error
+	// Iterate calls fn for every key with the given prefix in the ascending key order
+	Iterate(prefix []byte, fn func(key, value []byte) error) error
+	Close() error
+}
+
+//nolint:ireturn
+func NewStorageBackend(cfg *common.Config) (StorageBackend, error) {
+	switch cfg.StorageBackend {
+	case LevelDBBackend:
+		return NewLevelDB(cfg.StoragePath)
+	case MemoryBackend:
+		return NewMemoryStorage(), nil
+	default:
+		return nil, errors.Errorf("unknown storage backend: %s", cfg.StorageBackend)
+	}
+}
@@ -0,0 +1,153 @@
+package logic
+
+import (
+	"bufio"
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"maps"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// provingTimeSmoothing is the weight of the latest proving time in the moving average
+const provingTimeSmoothing = 0.3
+
+// CapacityMeter keeps the node's own capacity that is advertised in the status messages,
+// and hands out the proving slots, limited in total and per consumer
+type CapacityMeter struct {
+	capacity common.Capacity
+	limits   map[string]int // proving slots per consumer image
+	jobs     map[string]int // active jobs per consumer image
+	released chan struct{}  // closed when a slot is released
+	mu       sync.Mutex
+}
+
+func NewCapacityMeter(cfg *common.Config) *CapacityMeter {
+	cores := cfg.NodeCores
+	if cores <= 0 {
+		cores = runtime.NumCPU()
+	}
+
+	memory := cfg.NodeMemoryMB
+	if memory == 0 {
+		memory = totalMemoryMB()
+	}
+
+	limits := make(map[string]int, len(cfg.Consumers))
+	for _, image := range cfg.Consumers {
+		limits[image] = cfg.ConsumerSlotsFor(image)
+	}
+
+	return &CapacityMeter{
+		capacity: common.Capacity{
+			Cores:        cores,
+			MemoryMB:     memory,
+			Slots:        cfg.ProvingSlots,
+			ProvingTimes: make(map[string]int64),
+		},
+		limits:   limits,
+		jobs:     make(map[string]int),
+		released: make(chan struct{}),
+	}
+}
+
+func (m *CapacityMeter) Snapshot() common.Capacity {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	res := m.capacity
+	res.ProvingTimes = maps.Clone(m.capacity.ProvingTimes)
+	res.FreeSlots = make(map[string]int, len(m.limits))
+	for image := range m.limits {
+		res.FreeSlots[image] = m.freeSlots(image)
+	}
+
+	return res
+}
+
+// TryAcquire takes a slot of the consumer if there is a free one
+func (m *CapacityMeter) TryAcquire(consumerImage string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.freeSlots(consumerImage) == 0 {
+		return false
+	}
+
+	m.capacity.ActiveJobs++
+	m.jobs[consumerImage]++
+
+	return true
+}
+
+// Released returns a channel that is closed once a slot is released
+func (m *CapacityMeter) Released() <-chan struct{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.released
+}
+
+func (m *CapacityMeter) SetQueue(queued, size int) {
+	m.mu.Lock()
+	m.capacity.QueuedJobs = queued
+	m.capacity.QueueSize = size
+	m.mu.Unlock()
+}
+
+// Release frees the slot and updates the proving time of the consumer, the failed jobs aren't measured
+func (m *CapacityMeter) Release(consumerImage string, duration time.Duration, failed bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.capacity.ActiveJobs = max(m.capacity.ActiveJobs-1, 0)
+	m.jobs[consumerImage] = max(m.jobs[consumerImage]-1, 0)
+	close(m.released)
+	m.released = make(chan struct{})
+
+	if failed {
+		return
+	}
+
+	ms := duration.Milliseconds()
+	if prev, ok := m.capacity.ProvingTimes[consumerImage]; ok {
+		ms = int64(provingTimeSmoothing*float64(ms) + (1-provingTimeSmoothing)*float64(prev))
+	}
+	m.capacity.ProvingTimes[consumerImage] = max(ms, 1)
+}
+
+func (m *CapacityMeter) freeSlots(consumerImage string) int {
+	limit, ok := m.limits[consumerImage]
+	if !ok {
+		return 0
+	}
+
+	return max(min(m.capacity.Slots-m.capacity.ActiveJobs, limit-m.jobs[consumerImage]), 0)
+}
+
+// totalMemoryMB reads the memory size on linux, 0 means unknown
+func totalMemoryMB() uint64 {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "MemTotal:" {
+			kb, err := strconv.ParseUint(fields[1], 10, 64)
+			if err != nil {
+				return 0
+			}
+
+			return kb / 1024
+		}
+	}
+
+	return 0
+}
@@ -29,7 +29,7 @@ func (c *ConnectionHolder) Add(conn core.Conn) {
 
 	if ok {
 		if err := oldConn.Close(); err != nil {
-			slog.Error("error on closing old connection", err)
+			slog.Error("error on closing old connection", slog.String("err", err.Error()))
 		}
 	}
 }
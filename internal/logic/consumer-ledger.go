@@ -0,0 +1,205 @@
+package logic
+
+import (
+	"context"
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"log/slog"
+	"math/big"
+	"sync"
+	"time"
+)
+
+var ErrInsufficientBalance = errors.New("consumer balance doesn't cover the reward")
+
+// ConsumerLedger keeps the rewards the consumers committed to in the admitted requests, a request is only admitted
+// when the consumer's on-chain balance covers its reward on top of them. The contract takes the reward from the balance
+// when it's claimed, so every request holds a persisted reservation until its reward is claimed or the request fails
+type ConsumerLedger struct {
+	storage     *Storage
+	np          *NetworkParticipants
+	ethereum    *connectors.Ethereum
+	testingMode bool
+	interval    time.Duration
+	mu          sync.Mutex
+}
+
+func NewConsumerLedger(cfg *common.Config, storage *Storage, np *NetworkParticipants, eth *connectors.Ethereum) *ConsumerLedger {
+	return &ConsumerLedger{
+		storage:     storage,
+		np:          np,
+		ethereum:    eth,
+		testingMode: cfg.Mode == common.TestingMode,
+		interval:    cfg.StakeRefreshInterval,
+	}
+}
+
+// Outstanding returns the sum of the consumer's reservations
+func (l *ConsumerLedger) Outstanding(consumer ethcommon.Address) (*big.Int, error) {
+	reservations, err := l.storage.ListReservations()
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(big.Int)
+	for _, reservation := range reservations {
+		if ethcommon.HexToAddress(reservation.ConsumerAddress) == consumer {
+			res.Add(res, reservation.Reward)
+		}
+	}
+
+	return res, nil
+}
+
+// Check returns ErrInsufficientBalance when the consumer can't cover the request
+func (l *ConsumerLedger) Check(msg common.ProvingRequestMessage) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.check(msg)
+}
+
+// Reserve saves the request once the consumer is known to cover it, so the concurrent requests can't overcommit the balance
+func (l *ConsumerLedger) Reserve(msg common.ProvingRequestMessage) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.check(msg); err != nil {
+		return err
+	}
+
+	if err := l.reserve(msg); err != nil {
+		return err
+	}
+
+	return l.storage.SaveRequest(msg)
+}
+
+// ReserveSynced reserves the rewards of the requests received in the initial sync, they were admitted by the other nodes
+func (l *ConsumerLedger) ReserveSynced(requests map[common.RequestID]common.RequestExtension) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, req := range requests {
+		if err := l.reserve(req.ProvingRequestMessage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Release drops the reservation of the request, it's a no-op for the requests without one
+func (l *ConsumerLedger) Release(requestID common.RequestID) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.storage.DeleteReservation(requestID)
+}
+
+// Run releases the reservations of the requests whose rewards are claimed or whose failure is recorded by the contract,
+// the claims can be made by any prover, so the contract is the only place to learn about them
+func (l *ConsumerLedger) Run(ctx context.Context) {
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := l.releaseSettled(ctx); err != nil {
+				slog.Error("error releasing the settled reservations", slog.String("err", err.Error()))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (l *ConsumerLedger) releaseSettled(ctx context.Context) error {
+	reservations, err := l.storage.ListReservations()
+	if err != nil {
+		return err
+	}
+
+	var released bool
+	for _, reservation := range reservations {
+		settled, err := l.isSettled(ctx, reservation.RequestID)
+		if err != nil {
+			slog.Warn("error checking the reservation", slog.String("requestID", reservation.RequestID), slog.String("err", err.Error()))
+
+			continue
+		}
+
+		if !settled {
+			continue
+		}
+
+		if err := l.Release(reservation.RequestID); err != nil {
+			return err
+		}
+		released = true
+	}
+
+	if !released {
+		return nil
+	}
+
+	// the claims have lowered the balances, they're re-read before the released rewards can be committed again
+	return l.np.refreshConsumers(ctx)
+}
+
+func (l *ConsumerLedger) isSettled(ctx context.Context, requestID common.RequestID) (bool, error) {
+	_, claimed, err := l.ethereum.GetPayout(ctx, requestID)
+	if err == nil {
+		return claimed, nil
+	}
+
+	if !errors.Is(err, connectors.ErrNoPayout) {
+		return false, err
+	}
+
+	return l.ethereum.IsRequestFailed(ctx, requestID)
+}
+
+func (l *ConsumerLedger) reserve(msg common.ProvingRequestMessage) error {
+	if l.testingMode || msg.Reward == nil || msg.Reward.Sign() == 0 {
+		return nil
+	}
+
+	return l.storage.SaveReservation(common.Reservation{
+		RequestID:       msg.ID,
+		ConsumerAddress: msg.ConsumerAddress,
+		Reward:          msg.Reward,
+	})
+}
+
+func (l *ConsumerLedger) check(msg common.ProvingRequestMessage) error {
+	if l.testingMode || msg.Reward == nil || msg.Reward.Sign() == 0 {
+		return nil
+	}
+
+	addr := ethcommon.HexToAddress(msg.ConsumerAddress)
+	consumer, ok := l.np.GetConsumer(addr)
+	if !ok {
+		return errors.Wrapf(ErrUnknownConsumer, "address: %s", addr.Hex())
+	}
+
+	balance := consumer.Balance
+	if balance == nil {
+		balance = new(big.Int)
+	}
+
+	outstanding, err := l.Outstanding(addr)
+	if err != nil {
+		return errors.Wrap(err, "error summing the reservations")
+	}
+
+	required := new(big.Int).Add(outstanding, msg.Reward)
+	if balance.Cmp(required) < 0 {
+		return errors.Wrapf(ErrInsufficientBalance, "balance: %s wei, committed with the request: %s wei", balance, required)
+	}
+
+	return nil
+}
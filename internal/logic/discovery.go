@@ -62,7 +62,7 @@ func (d *Discovery) listen(ctx context.Context, ch <-chan peer.AddrInfo) {
 			if d.host.Network().Connectedness(p.ID) != network.Connected && d.connections.Len() < connectivityFactor {
 				conn, err := d.host.Network().DialPeer(ctx, p.ID)
 				if err != nil {
-					slog.Error("error on dialing peer", err, slog.String("peerID", p.ID.String()))
+					slog.Error("error on dialing peer", slog.String("err", err.Error()), slog.String("peerID", p.ID.String()))
 					continue
 				}
 				slog.Info("Connected to peer", slog.String("peerID", p.ID.String()))
@@ -0,0 +1,134 @@
+package logic
+
+import (
+	"context"
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const subscriberBufferSize = 64
+const eventsRetention = time.Hour
+const unfinishedEventsRetention = 24 * time.Hour
+
+type requestHistory struct {
+	events     []common.RequestEvent
+	updatedAt  time.Time
+	finishedAt time.Time
+}
+
+// EventBus delivers request events to the subscribers, late subscribers get a replay of the events so far.
+// A subscriber that doesn't keep up has its channel closed, it can subscribe again to get the replay
+type EventBus struct {
+	history     map[common.RequestID]*requestHistory
+	subscribers map[common.RequestID]map[chan common.RequestEvent]struct{}
+	mu          sync.Mutex
+}
+
+func NewEventBus() *EventBus {
+	bus := &EventBus{
+		history:     make(map[common.RequestID]*requestHistory),
+		subscribers: make(map[common.RequestID]map[chan common.RequestEvent]struct{}),
+	}
+
+	return bus
+}
+
+func (b *EventBus) Publish(event common.RequestEvent) {
+	if event.Timestamp == 0 {
+		event.Timestamp = time.Now().UnixNano()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	history, ok := b.history[event.RequestID]
+	if !ok {
+		history = &requestHistory{}
+		b.history[event.RequestID] = history
+	}
+
+	history.events = append(history.events, event)
+	history.updatedAt = time.Now()
+	if event.Type.IsTerminal() {
+		history.finishedAt = history.updatedAt
+	}
+
+	for ch := range b.subscribers[event.RequestID] {
+		select {
+		case ch <- event:
+		default:
+			slog.Warn("subscriber is too slow, closing it",
+				slog.String("requestID", event.RequestID),
+				slog.String("type", event.Type.String()),
+			)
+			b.unsubscribeLocked(event.RequestID, ch)
+			close(ch)
+		}
+	}
+}
+
+// Subscribe returns the events published so far and the channel for the new ones, cancel has to be called to unsubscribe.
+// The channel is closed when the subscriber falls behind
+func (b *EventBus) Subscribe(requestID common.RequestID) ([]common.RequestEvent, <-chan common.RequestEvent, func()) {
+	ch := make(chan common.RequestEvent, subscriberBufferSize)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var replay []common.RequestEvent
+	if history, ok := b.history[requestID]; ok {
+		replay = append(replay, history.events...)
+	}
+
+	if _, ok := b.subscribers[requestID]; !ok {
+		b.subscribers[requestID] = make(map[chan common.RequestEvent]struct{})
+	}
+	b.subscribers[requestID][ch] = struct{}{}
+
+	cancel := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		b.unsubscribeLocked(requestID, ch)
+	}
+
+	return replay, ch, cancel
+}
+
+func (b *EventBus) unsubscribeLocked(requestID common.RequestID, ch chan common.RequestEvent) {
+	delete(b.subscribers[requestID], ch)
+	if len(b.subscribers[requestID]) == 0 {
+		delete(b.subscribers, requestID)
+	}
+}
+
+// Run drops the histories of the finished requests after eventsRetention, and the ones of the requests that didn't
+// get any event for unfinishedEventsRetention, as they may never finish on this node
+func (b *EventBus) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.cleanHistory()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (b *EventBus) cleanHistory() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for requestID, history := range b.history {
+		finished := !history.finishedAt.IsZero() && time.Since(history.finishedAt) > eventsRetention
+		stale := time.Since(history.updatedAt) > unfinishedEventsRetention
+		if finished || stale {
+			delete(b.history, requestID)
+		}
+	}
+}
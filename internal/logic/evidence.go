@@ -0,0 +1,125 @@
+package logic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
+	"github.com/dimazhornyk/generic-proving-network/internal/metrics"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/pkg/errors"
+	"log/slog"
+	"time"
+)
+
+// MinInvalidProofVotes mirrors MIN_INVALID_PROOF_VOTES of the contract
+const MinInvalidProofVotes = connectors.MinInvalidProofVotes
+
+type signedMessageKey struct{}
+
+// WithSignedMessage keeps the raw pubsub message in ctx, so the handlers can attach it to the evidence
+func WithSignedMessage(ctx context.Context, raw []byte) context.Context {
+	return context.WithValue(ctx, signedMessageKey{}, raw)
+}
+
+func SignedMessage(ctx context.Context) []byte {
+	raw, _ := ctx.Value(signedMessageKey{}).([]byte)
+
+	return raw
+}
+
+// EvidenceCollector persists the evidence of misbehaviour and submits the slashable one to the contract
+type EvidenceCollector struct {
+	host     host.Host
+	storage  *Storage
+	ethereum *connectors.Ethereum
+	submit   bool
+}
+
+func NewEvidenceCollector(cfg *common.Config, host host.Host, storage *Storage, eth *connectors.Ethereum) *EvidenceCollector {
+	return &EvidenceCollector{
+		host:     host,
+		storage:  storage,
+		ethereum: eth,
+		submit:   cfg.SubmitEvidence,
+	}
+}
+
+// Record is idempotent, all the nodes derive the same ID for the same misbehaviour
+func (c *EvidenceCollector) Record(ctx context.Context, evidence common.Evidence) error {
+	if len(evidence.SignedMessage) != 0 {
+		author, _, err := connectors.VerifySignedMessage(evidence.SignedMessage)
+		if err != nil {
+			return errors.Wrap(err, "error verifying the offender's message")
+		}
+
+		if author != evidence.Offender {
+			return errors.Errorf("message is signed by %s, not by the offender", author.String())
+		}
+	}
+
+	evidence.ID = evidenceID(evidence)
+	evidence.Reporter = c.host.ID()
+	evidence.CreatedAt = time.Now().UnixNano()
+
+	saved, err := c.storage.SaveEvidence(evidence)
+	if err != nil {
+		return err
+	}
+
+	if !saved {
+		return nil
+	}
+
+	metrics.EvidenceRecorded.WithLabelValues(evidence.Type.String()).Inc()
+	slog.Warn("recorded misbehaviour evidence",
+		slog.String("type", evidence.Type.String()),
+		slog.String("offender", evidence.Offender.String()),
+		slog.String("requestID", evidence.RequestID),
+	)
+
+	// the invalid proof is slashable once the failure report rejects it, a node elected by the voting handler submits it
+	if !c.submit || !evidence.Type.IsSlashable() || evidence.Type == common.EvidenceInvalidProof {
+		return nil
+	}
+
+	_, err = c.Submit(ctx, evidence.ID)
+
+	return err
+}
+
+// Submit sends the evidence to the contract, returns the hash of the mined transaction
+func (c *EvidenceCollector) Submit(ctx context.Context, id string) (string, error) {
+	evidence, err := c.storage.GetEvidence(id)
+	if err != nil {
+		return "", err
+	}
+
+	if evidence.SubmissionTx != "" {
+		return evidence.SubmissionTx, nil
+	}
+
+	txHash, err := c.ethereum.SubmitEvidence(ctx, evidence)
+	if err != nil {
+		return "", errors.Wrap(err, "error submitting evidence")
+	}
+
+	if err := c.storage.SetEvidenceSubmitted(id, txHash); err != nil {
+		return "", errors.Wrap(err, "error marking evidence as submitted")
+	}
+
+	return txHash, nil
+}
+
+func (c *EvidenceCollector) List(requestID common.RequestID) ([]common.Evidence, error) {
+	return c.storage.ListEvidence(requestID)
+}
+
+func evidenceID(evidence common.Evidence) string {
+	key := fmt.Sprintf("%d/%s/%s/%s", evidence.Type, evidence.RequestID, evidence.Offender, evidence.ProverID)
+	hash := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(hash[:])
+}
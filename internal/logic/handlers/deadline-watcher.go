@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/logic"
+	"github.com/dimazhornyk/generic-proving-network/internal/metrics"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+const deadlineCheckInterval = time.Second * 5
+
+// DeadlineWatcher re-selects the prover when the selected one doesn't publish the proof in time, and fails the request
+// when the prover isn't agreed on in time. Every node watches the deadlines on its own, the excluded peers are the same
+// everywhere, so is the next prover
+type DeadlineWatcher struct {
+	cfg           *common.Config
+	storage       *logic.Storage
+	events        *logic.EventBus
+	votingHandler *VotingHandler
+}
+
+func NewDeadlineWatcher(cfg *common.Config, storage *logic.Storage, events *logic.EventBus, vh *VotingHandler) *DeadlineWatcher {
+	return &DeadlineWatcher{
+		cfg:           cfg,
+		storage:       storage,
+		events:        events,
+		votingHandler: vh,
+	}
+}
+
+func (w *DeadlineWatcher) Watch(ctx context.Context) {
+	ticker := time.NewTicker(deadlineCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.checkDeadlines(ctx)
+		}
+	}
+}
+
+func (w *DeadlineWatcher) checkDeadlines(ctx context.Context) {
+	for _, req := range w.storage.GetRequests() {
+		if req.Phase == common.PhaseSelectingProver {
+			w.checkSelectionDeadline(ctx, req)
+
+			continue
+		}
+
+		if req.Phase != common.PhaseProving || len(req.ProvingPeers) == 0 {
+			continue
+		}
+
+		proverID := req.ProvingPeers[len(req.ProvingPeers)-1]
+		if _, ok := req.Proofs[proverID]; ok || slices.Contains(req.TimedOutPeers, proverID) {
+			continue
+		}
+
+		deadline := time.Unix(0, req.ProvingStartedAt).Add(w.cfg.ProvingDeadlineFor(req.ConsumerImage))
+		if time.Now().Before(deadline) {
+			continue
+		}
+
+		slog.Warn("prover missed the proving deadline",
+			slog.String("requestID", req.ID),
+			slog.String("peerID", proverID.String()),
+		)
+
+		if err := w.storage.RecordProvingTimeout(req.ID, proverID); err != nil {
+			slog.Error("error recording proving timeout", slog.String("requestID", req.ID), slog.String("err", err.Error()))
+
+			continue
+		}
+
+		metrics.ProvingTimeouts.WithLabelValues(req.ConsumerImage, proverID.String()).Inc()
+		w.events.Publish(common.RequestEvent{
+			RequestID: req.ID,
+			Type:      common.EventProverTimedOut,
+			PeerID:    proverID,
+		})
+
+		// the selection may end up computing the proof on this node, so it doesn't block the other requests
+		go func(requestID common.RequestID) {
+			if err := w.votingHandler.reselectProver(ctx, requestID, proverID); err != nil {
+				slog.Error("error re-selecting prover", slog.String("requestID", requestID), slog.String("err", err.Error()))
+			}
+		}(req.ID)
+	}
+}
+
+// checkSelectionDeadline fails the request whose selection round didn't reach the quorum or couldn't start for lack of
+// candidates, the request would stay in the selection and keep the consumer's reservation otherwise
+func (w *DeadlineWatcher) checkSelectionDeadline(ctx context.Context, req common.RequestExtension) {
+	// the requests stored before the start of the selection was recorded count from the request itself
+	startedAt := req.SelectionStartedAt
+	if startedAt == 0 {
+		startedAt = req.Timestamp
+	}
+
+	deadline := time.Unix(0, startedAt).Add(w.cfg.SelectionDeadline)
+	if time.Now().Before(deadline) {
+		return
+	}
+
+	slog.Warn("prover selection missed the deadline",
+		slog.String("requestID", req.ID),
+		slog.Int("attempt", len(req.ProvingPeers)),
+	)
+
+	// the failure report waits for the reporters ranked before this node
+	go func() {
+		if err := w.votingHandler.failRequest(ctx, req); err != nil {
+			slog.Error("error failing the request", slog.String("requestID", req.ID), slog.String("err", err.Error()))
+		}
+	}()
+}
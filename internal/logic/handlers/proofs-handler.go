@@ -3,28 +3,32 @@ package handlers
 import (
 	"context"
 	"crypto/ecdsa"
-	"encoding/json"
 	"github.com/dimazhornyk/generic-proving-network/internal/common"
 	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
 	"github.com/dimazhornyk/generic-proving-network/internal/logic"
+	"github.com/dimazhornyk/generic-proving-network/internal/metrics"
+	"github.com/dimazhornyk/generic-proving-network/internal/tracing"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
 	"log/slog"
+	"slices"
 	"time"
 )
 
 type ProofsHandler struct {
 	host     host.Host
-	nodesMap logic.StatusMap
+	nodesMap *logic.StatusMap
 	storage  *logic.Storage
 	service  *logic.Service
 	pubsub   *connectors.PubSub
 	key      *ecdsa.PrivateKey
+	events   *logic.EventBus
 }
 
-func NewProofsHandler(key *ecdsa.PrivateKey, host host.Host, storage *logic.Storage, service *logic.Service, pubsub *connectors.PubSub, nodesMap logic.StatusMap) *ProofsHandler {
+func NewProofsHandler(key *ecdsa.PrivateKey, host host.Host, storage *logic.Storage, service *logic.Service, pubsub *connectors.PubSub, nodesMap *logic.StatusMap, events *logic.EventBus) *ProofsHandler {
 	return &ProofsHandler{
 		host:     host,
 		storage:  storage,
@@ -32,11 +36,20 @@ func NewProofsHandler(key *ecdsa.PrivateKey, host host.Host, storage *logic.Stor
 		pubsub:   pubsub,
 		key:      key,
 		nodesMap: nodesMap,
+		events:   events,
 	}
 }
 
 func (h *ProofsHandler) Handle(ctx context.Context, peerID peer.ID, msg common.ProofSubmissionMessage) {
+	ctx, span := tracing.Start(ctx, "ProofsHandler.Handle", trace.WithAttributes(
+		tracing.RequestID(msg.RequestID),
+		tracing.PeerID(peerID),
+	))
+	defer span.End()
+
 	if peerID == h.host.ID() {
+		h.publishProofReceived(peerID, msg)
+
 		return // no need to verify the proof that we just generated
 	}
 
@@ -47,13 +60,22 @@ func (h *ProofsHandler) Handle(ctx context.Context, peerID peer.ID, msg common.P
 		return
 	}
 
-	if err := h.storage.AddProof(msg.RequestID, peerID, msg.ProofID, msg.Proof); err != nil {
-		slog.Error("error adding proof to storage", slog.String("err", err.Error()))
+	// the proofs of the superseded provers are rejected here, so they don't move the current attempt on
+	err = h.storage.AddProof(msg.RequestID, peerID, msg.ProofID, msg.Proof)
+	if errors.Is(err, logic.ErrNotCurrentProver) && !slices.Contains(reqData.ProvingPeers, peerID) {
+		// the selection round may close on this node after the prover has started
+		time.Sleep(DoubleCheckInterval)
+		err = h.storage.AddProof(msg.RequestID, peerID, msg.ProofID, msg.Proof)
+	}
+
+	if err != nil {
+		slog.Error("error adding proof to storage", slog.String("requestID", msg.RequestID), slog.String("err", err.Error()))
 
 		return
 	}
+	h.publishProofReceived(peerID, msg)
 
-	// TODO: check if the node's status was Proving, so the state transition was correct
+	metrics.RequestToProofDuration.WithLabelValues(reqData.ConsumerImage).Observe(time.Since(time.Unix(0, reqData.Timestamp)).Seconds())
 
 	valid, err := h.service.ValidateProof(msg.RequestID, reqData.ConsumerImage, reqData.Data, msg.Proof)
 	if err != nil {
@@ -62,7 +84,8 @@ func (h *ProofsHandler) Handle(ctx context.Context, peerID peer.ID, msg common.P
 		return
 	}
 
-	signature, err := h.getSignature(msg.RequestID, peerID, valid)
+	proofHash := common.ProofHash(msg.Proof)
+	signature, err := h.getSignature(msg.RequestID, peerID, proofHash, valid)
 	if err != nil {
 		slog.Error("error signing validation payload", slog.String("err", err.Error()))
 
@@ -75,6 +98,7 @@ func (h *ProofsHandler) Handle(ctx context.Context, peerID peer.ID, msg common.P
 		IsValid:             valid,
 		Signature:           signature,
 		ValidationTimestamp: time.Now().UnixNano(),
+		ProofHash:           proofHash,
 	}
 
 	votingMsg := common.VotingMessage{
@@ -89,27 +113,24 @@ func (h *ProofsHandler) Handle(ctx context.Context, peerID peer.ID, msg common.P
 	}
 }
 
-func (h *ProofsHandler) getSignature(requestID common.RequestID, peerID peer.ID, isValid bool) ([]byte, error) {
-	addr, err := common.PeerIDToEthAddress(peerID)
-	if err != nil {
-		return nil, errors.Wrap(err, "error converting peer ID to eth address")
-	}
-
-	dataToSign := common.DataToSign{
-		RequestID:     requestID,
-		ProverAddress: addr,
-		IsValid:       isValid,
-	}
+func (h *ProofsHandler) publishProofReceived(peerID peer.ID, msg common.ProofSubmissionMessage) {
+	h.events.Publish(common.RequestEvent{
+		RequestID: msg.RequestID,
+		Type:      common.EventProofReceived,
+		PeerID:    peerID,
+		ProofID:   msg.ProofID,
+	})
+}
 
-	b, err := json.Marshal(dataToSign)
+func (h *ProofsHandler) getSignature(requestID common.RequestID, peerID peer.ID, proofHash string, isValid bool) ([]byte, error) {
+	hash, err := common.ValidationHash(requestID, peerID, proofHash, isValid)
 	if err != nil {
-		return nil, errors.Wrap(err, "error marshaling a message")
+		return nil, err
 	}
 
-	hash := ethCrypto.Keccak256Hash(b)
-	sig, err := ethCrypto.Sign(hash.Bytes(), h.key)
+	sig, err := ethCrypto.Sign(hash, h.key)
 	if err != nil {
-		panic(err)
+		return nil, errors.Wrap(err, "error signing validation data")
 	}
 
 	return sig, nil
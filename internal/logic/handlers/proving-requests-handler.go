@@ -6,41 +6,64 @@ import (
 	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
 	"github.com/dimazhornyk/generic-proving-network/internal/logic"
 	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"log/slog"
 	"time"
 )
 
 type ProvingRequestsHandler struct {
-	host    host.Host
-	storage *logic.Storage
-	service *logic.Service
-	pubsub  *connectors.PubSub
+	host     host.Host
+	storage  *logic.Storage
+	service  *logic.Service
+	pubsub   *connectors.PubSub
+	events   *logic.EventBus
+	evidence *logic.EvidenceCollector
+	ledger   *logic.ConsumerLedger
 }
 
-func NewProvingRequestsHandler(host host.Host, storage *logic.Storage, service *logic.Service, pubsub *connectors.PubSub) *ProvingRequestsHandler {
+func NewProvingRequestsHandler(host host.Host, storage *logic.Storage, service *logic.Service, pubsub *connectors.PubSub, events *logic.EventBus, evidence *logic.EvidenceCollector, ledger *logic.ConsumerLedger) *ProvingRequestsHandler {
 	return &ProvingRequestsHandler{
-		host:    host,
-		storage: storage,
-		service: service,
-		pubsub:  pubsub,
+		host:     host,
+		storage:  storage,
+		service:  service,
+		pubsub:   pubsub,
+		events:   events,
+		evidence: evidence,
+		ledger:   ledger,
 	}
 }
 
-func (h *ProvingRequestsHandler) Handle(ctx context.Context, msg common.ProvingRequestMessage) {
+func (h *ProvingRequestsHandler) Handle(ctx context.Context, authorID peer.ID, msg common.ProvingRequestMessage) {
 	if err := validateProvingRequest(msg); err != nil {
-		// TODO: if it is invalid - take punishing actions
 		slog.Error("invalid proving request", slog.String("err", err.Error()))
+		h.recordInvalidRequest(ctx, authorID, msg.ID)
 
 		return
 	}
 
-	if err := h.storage.SaveRequest(msg); err != nil {
-		slog.Error("error saving proving request", slog.String("err", err.Error()))
+	if err := h.service.VerifyProvingRequest(msg); err != nil {
+		slog.Error("unauthorized proving request", slog.String("requestID", msg.ID), slog.String("err", err.Error()))
+
+		// the other errors depend on this node's view of the chain, so they don't prove anything
+		if errors.Is(err, logic.ErrInvalidConsumerSignature) {
+			h.recordInvalidRequest(ctx, authorID, msg.ID)
+		}
 
 		return
 	}
 
+	if err := h.ledger.Reserve(msg); err != nil {
+		slog.Error("error accepting proving request", slog.String("requestID", msg.ID), slog.String("err", err.Error()))
+
+		return
+	}
+
+	h.events.Publish(common.RequestEvent{
+		RequestID: msg.ID,
+		Type:      common.EventRequestAccepted,
+	})
+
 	if err := h.service.HandleProverSelection(ctx, msg); err != nil {
 		slog.Error("error handling prover selection", slog.String("err", err.Error()))
 
@@ -48,6 +71,19 @@ func (h *ProvingRequestsHandler) Handle(ctx context.Context, msg common.ProvingR
 	}
 }
 
+// recordInvalidRequest blames the node that published the request, the consumer's signature can't be forged by it
+func (h *ProvingRequestsHandler) recordInvalidRequest(ctx context.Context, authorID peer.ID, requestID common.RequestID) {
+	err := h.evidence.Record(ctx, common.Evidence{
+		Type:          common.EvidenceInvalidRequest,
+		Offender:      authorID,
+		RequestID:     requestID,
+		SignedMessage: logic.SignedMessage(ctx),
+	})
+	if err != nil {
+		slog.Error("error recording evidence", slog.String("requestID", requestID), slog.String("err", err.Error()))
+	}
+}
+
 func validateProvingRequest(msg common.ProvingRequestMessage) error {
 	if msg.ID == "" {
 		return errors.New("requestID is empty")
@@ -69,6 +105,10 @@ func validateProvingRequest(msg common.ProvingRequestMessage) error {
 		return errors.New("data is empty")
 	}
 
+	if msg.Reward == nil || msg.Reward.BitLen() > 256 {
+		return errors.New("reward doesn't fit uint256")
+	}
+
 	t := time.Unix(0, msg.Timestamp)
 	if t.After(time.Now()) {
 		return errors.New("timestamp is in the future")
@@ -78,10 +118,5 @@ func validateProvingRequest(msg common.ProvingRequestMessage) error {
 		return errors.New("timestamp is too old")
 	}
 
-	return verifySignature(msg.ConsumerImage, msg.Signature)
-}
-
-func verifySignature(consumerImage string, signature []byte) error {
-	// TODO: implement
 	return nil
 }
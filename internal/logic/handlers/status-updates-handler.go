@@ -9,10 +9,10 @@ import (
 )
 
 type StatusUpdatesHandler struct {
-	nodes logic.StatusMap
+	nodes *logic.StatusMap
 }
 
-func NewStatusUpdatesHandler(nodes logic.StatusMap) *StatusUpdatesHandler {
+func NewStatusUpdatesHandler(nodes *logic.StatusMap) *StatusUpdatesHandler {
 	return &StatusUpdatesHandler{
 		nodes: nodes,
 	}
@@ -26,11 +26,11 @@ func (h *StatusUpdatesHandler) Handle(peerID peer.ID, msg common.StatusMessage)
 	case common.StatusInit:
 		err = h.handleInit(peerID, msg)
 	case common.StatusIdle:
-		err = h.handleIdle(peerID)
+		err = h.handleIdle(peerID, msg)
 	case common.StatusShuttingDown:
-		err = h.handleShuttingDown(peerID)
+		err = h.handleShuttingDown(peerID, msg)
 	case common.StatusProving:
-		err = h.handleProving(peerID)
+		err = h.handleProving(peerID, msg)
 	}
 
 	if err != nil {
@@ -47,31 +47,38 @@ func (h *StatusUpdatesHandler) handleInit(peerID peer.ID, msg common.StatusMessa
 		return errors.New("invalid payload type for StatusInit")
 	}
 
-	h.nodes.Add(peerID, common.StatusInit, consumers)
+	h.nodes.Add(peerID, common.StatusInit, consumers, msg.Capacity)
 
 	return nil
 }
 
-func (h *StatusUpdatesHandler) handleIdle(peerID peer.ID) error {
-	if err := h.nodes.UpdateStatus(peerID, common.StatusIdle); err != nil {
+func (h *StatusUpdatesHandler) handleIdle(peerID peer.ID, msg common.StatusMessage) error {
+	if err := h.nodes.UpdateStatus(peerID, common.StatusIdle, commitmentsOf(msg), msg.Capacity); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (h *StatusUpdatesHandler) handleShuttingDown(peerID peer.ID) error {
-	if err := h.nodes.UpdateStatus(peerID, common.StatusShuttingDown); err != nil {
+func (h *StatusUpdatesHandler) handleShuttingDown(peerID peer.ID, msg common.StatusMessage) error {
+	if err := h.nodes.UpdateStatus(peerID, common.StatusShuttingDown, commitmentsOf(msg), msg.Capacity); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (h *StatusUpdatesHandler) handleProving(peerID peer.ID) error {
-	if err := h.nodes.UpdateStatus(peerID, common.StatusProving); err != nil {
+func (h *StatusUpdatesHandler) handleProving(peerID peer.ID, msg common.StatusMessage) error {
+	if err := h.nodes.UpdateStatus(peerID, common.StatusProving, commitmentsOf(msg), msg.Capacity); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// commitmentsOf returns the commitments sent with the heartbeat, the nodes of the older versions don't send them
+func commitmentsOf(msg common.StatusMessage) []string {
+	commitments, _ := msg.Payload.([]string)
+
+	return commitments
+}
@@ -1,27 +1,30 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
-	"encoding/json"
+	"crypto/sha256"
 	"github.com/dimazhornyk/generic-proving-network/internal/common"
 	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
 	"github.com/dimazhornyk/generic-proving-network/internal/logic"
-	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/dimazhornyk/generic-proving-network/internal/metrics"
+	"github.com/dimazhornyk/generic-proving-network/internal/tracing"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
 	"log/slog"
-	"strings"
+	"slices"
+	"sync"
 	"time"
 )
 
 const maxProvingAttempts = 3
 const DoubleCheckInterval = time.Second * 2
-const SelectionVotingDuration = time.Second * 4
-const ValidationVotingDuration = time.Second * 30
 
 var errInvalidSignature = errors.New("invalid signature")
+var errEquivocation = errors.New("voter has voted for a different value")
 var errCantVerifySignature = errors.New("can't verify signature")
 
 type VotingHandler struct {
@@ -31,11 +34,34 @@ type VotingHandler struct {
 	service           *logic.Service
 	pubsub            *connectors.PubSub
 	ethereum          *connectors.Ethereum
-	selectionVotings  logic.VotingMap[common.RequestID, peer.ID]
-	validationVotings logic.VotingMap[common.RequestID, bool]
+	submitter         *logic.ProofSubmitter
+	payouts           *logic.PayoutTracker
+	ledger            *logic.ConsumerLedger
+	events            *logic.EventBus
+	evidence          *logic.EvidenceCollector
+	weigher           *logic.VoteWeigher
+	nodes             *logic.StatusMap
+	cfg               *common.Config
+	reportFailures    bool
+	selectionVotings  *logic.VotingMap[selectionKey, peer.ID]
+	validationVotings *logic.VotingMap[validationKey, bool]
+	declines          map[selectionKey]peer.ID // the provers that declined, kept until their selection is stored
+	declinesMu        sync.Mutex
 }
 
-func NewVotingHandler(host host.Host, key *ecdsa.PrivateKey, service *logic.Service, storage *logic.Storage, pubsub *connectors.PubSub, eth *connectors.Ethereum) *VotingHandler {
+// selectionKey separates the reselections of the same request
+type selectionKey struct {
+	RequestID common.RequestID
+	Attempt   int
+}
+
+// validationKey separates the votes for the proofs of the different provers of the same request
+type validationKey struct {
+	RequestID common.RequestID
+	ProverID  peer.ID
+}
+
+func NewVotingHandler(cfg *common.Config, host host.Host, key *ecdsa.PrivateKey, service *logic.Service, storage *logic.Storage, pubsub *connectors.PubSub, eth *connectors.Ethereum, events *logic.EventBus, evidence *logic.EvidenceCollector, weigher *logic.VoteWeigher, nodes *logic.StatusMap, submitter *logic.ProofSubmitter, payouts *logic.PayoutTracker, ledger *logic.ConsumerLedger) *VotingHandler {
 	return &VotingHandler{
 		host:              host,
 		key:               key,
@@ -43,8 +69,18 @@ func NewVotingHandler(host host.Host, key *ecdsa.PrivateKey, service *logic.Serv
 		storage:           storage,
 		pubsub:            pubsub,
 		ethereum:          eth,
-		selectionVotings:  make(logic.VotingMap[common.RequestID, peer.ID]),
-		validationVotings: make(logic.VotingMap[common.RequestID, bool]),
+		submitter:         submitter,
+		payouts:           payouts,
+		ledger:            ledger,
+		events:            events,
+		evidence:          evidence,
+		weigher:           weigher,
+		nodes:             nodes,
+		cfg:               cfg,
+		reportFailures:    cfg.ReportFailures,
+		selectionVotings:  logic.NewVotingMap[selectionKey, peer.ID](selectionTieBreak),
+		validationVotings: logic.NewVotingMap[validationKey, bool](validationTieBreak),
+		declines:          make(map[selectionKey]peer.ID),
 	}
 }
 
@@ -52,17 +88,21 @@ func (h *VotingHandler) Handle(ctx context.Context, peerID peer.ID, msg common.V
 	var err error
 	switch msg.Type {
 	case common.VoteProverSelection:
-		err = h.handleSelectionVoting(peerID, msg)
+		err = h.handleSelectionVoting(ctx, peerID, msg)
 	case common.VoteValidation:
 		err = h.handleValidationVoting(ctx, peerID, msg)
+	case common.VoteProverDeclined:
+		err = h.handleProverDeclined(ctx, peerID, msg)
+	case common.VoteProofSubmitted:
+		err = h.handleProofSubmitted(ctx, peerID, msg)
 	}
 
 	if err != nil {
-		slog.Error("error handling voting message", err)
+		slog.Error("error handling voting message", slog.String("err", err.Error()))
 	}
 }
 
-func (h *VotingHandler) handleSelectionVoting(voterID peer.ID, message common.VotingMessage) error {
+func (h *VotingHandler) handleSelectionVoting(ctx context.Context, voterID peer.ID, message common.VotingMessage) error {
 	payload, ok := message.Payload.(common.ProverSelectionPayload)
 	if !ok {
 		return errors.New("invalid payload type for VoteProverSelection")
@@ -76,14 +116,32 @@ func (h *VotingHandler) handleSelectionVoting(voterID peer.ID, message common.Vo
 		}
 	}
 
-	if !h.selectionVotings.Add(payload.RequestID, voterID, payload.PeerID) {
-		time.Sleep(SelectionVotingDuration)
-		winner, err := h.selectionVotings.GetWinner(payload.RequestID) // TODO: handle draw and empty voting
+	// the selection votes aren't signed on their own, the signed pubsub message proves the vote
+	key := selectionKey{RequestID: payload.RequestID, Attempt: payload.Attempt}
+	votingExists, equivocation := h.selectionVotings.Add(key, voterID, payload.PeerID, logic.SignedMessage(ctx))
+	if equivocation != nil {
+		slog.Warn("equivocating selection vote",
+			slog.String("requestID", payload.RequestID),
+			slog.String("voter", voterID.String()),
+			slog.String("first", equivocation.First.Value.String()),
+			slog.String("second", equivocation.Second.Value.String()),
+		)
+
+		return errEquivocation
+	}
+
+	if !votingExists {
+		request, err := h.storage.GetProvingRequestByID(payload.RequestID)
+		if err != nil {
+			return errors.Wrap(err, "error getting proving request")
+		}
+
+		winner, err := h.selectionVotings.Await(ctx, key, h.selectionRound(request.ConsumerImage))
+		h.selectionVotings.Close(key)
 		if err != nil {
 			return errors.Wrap(err, "error getting winner")
 		}
 
-		h.selectionVotings.Delete(payload.RequestID)
 		if winner == nil {
 			return errors.New("no selection votes")
 		}
@@ -91,11 +149,93 @@ func (h *VotingHandler) handleSelectionVoting(voterID peer.ID, message common.Vo
 		if err := h.storage.AddProvingPeer(payload.RequestID, *winner); err != nil {
 			return errors.Wrap(err, "error adding proving peer")
 		}
+
+		h.events.Publish(common.RequestEvent{
+			RequestID: payload.RequestID,
+			Type:      common.EventProverSelected,
+			PeerID:    *winner,
+		})
+
+		// the proving starts once the network agrees on the prover, not on the local selection
+		if *winner == h.host.ID() {
+			if err := h.service.EnqueueProving(ctx, request.ProvingRequestMessage, key.Attempt); err != nil {
+				slog.Error("error queueing the proving", slog.String("requestID", payload.RequestID), slog.String("err", err.Error()))
+			}
+		}
+
+		// the prover could decline before the voting was over here
+		h.maybeReselectDeclined(ctx, key)
+	}
+
+	return nil
+}
+
+// handleProverDeclined re-selects the prover right away instead of waiting for the proving deadline,
+// only the prover itself can decline, the pubsub message is signed by it
+func (h *VotingHandler) handleProverDeclined(ctx context.Context, proverID peer.ID, message common.VotingMessage) error {
+	payload, ok := message.Payload.(common.ProverDeclinedPayload)
+	if !ok {
+		return errors.New("invalid payload type for VoteProverDeclined")
 	}
 
+	key := selectionKey{RequestID: payload.RequestID, Attempt: payload.Attempt}
+	h.declinesMu.Lock()
+	h.declines[key] = proverID
+	h.declinesMu.Unlock()
+
+	h.maybeReselectDeclined(ctx, key)
+
 	return nil
 }
 
+// maybeReselectDeclined re-selects the prover once both the decline and the selection of the decliner are known
+func (h *VotingHandler) maybeReselectDeclined(ctx context.Context, key selectionKey) {
+	h.declinesMu.Lock()
+	defer h.declinesMu.Unlock()
+
+	proverID, ok := h.declines[key]
+	if !ok {
+		return
+	}
+
+	req, err := h.storage.GetProvingRequestByID(key.RequestID)
+	if err != nil {
+		delete(h.declines, key)
+
+		return
+	}
+
+	if len(req.ProvingPeers) != key.Attempt+1 {
+		return
+	}
+	delete(h.declines, key)
+
+	if req.ProvingPeers[key.Attempt] != proverID || slices.Contains(req.TimedOutPeers, proverID) {
+		return
+	}
+
+	slog.Info("prover declined the request", slog.String("requestID", req.ID), slog.String("peerID", proverID.String()))
+
+	// the decline is recorded as a timeout, so the deadline watcher doesn't re-select the prover again
+	if err := h.storage.RecordProvingTimeout(req.ID, proverID); err != nil {
+		slog.Error("error recording the decline", slog.String("requestID", req.ID), slog.String("err", err.Error()))
+
+		return
+	}
+
+	h.events.Publish(common.RequestEvent{
+		RequestID: req.ID,
+		Type:      common.EventProverTimedOut,
+		PeerID:    proverID,
+	})
+
+	go func() {
+		if err := h.reselectProver(ctx, req.ID, proverID); err != nil {
+			slog.Error("error re-selecting prover", slog.String("requestID", req.ID), slog.String("err", err.Error()))
+		}
+	}()
+}
+
 func (h *VotingHandler) handleValidationVoting(ctx context.Context, voterID peer.ID, message common.VotingMessage) error {
 	payload, ok := message.Payload.(common.ValidationPayload)
 	if !ok {
@@ -109,7 +249,15 @@ func (h *VotingHandler) handleValidationVoting(ctx context.Context, voterID peer
 
 	if err := h.checkValidationSignature(voterID, payload); err != nil {
 		if errors.Is(err, errInvalidSignature) || errors.Is(err, errCantVerifySignature) {
-			// TODO: punish the node that has submitted the invalid signature
+			h.recordEvidence(ctx, common.Evidence{
+				Type:          common.EvidenceForgedSignature,
+				Offender:      voterID,
+				RequestID:     payload.RequestID,
+				ProverID:      payload.ProverID,
+				ProofHash:     payload.ProofHash,
+				Votes:         []common.SignedVote{{VoterID: voterID, IsValid: payload.IsValid, Signature: payload.Signature}},
+				SignedMessage: logic.SignedMessage(ctx),
+			})
 
 			return err
 		}
@@ -117,94 +265,510 @@ func (h *VotingHandler) handleValidationVoting(ctx context.Context, voterID peer
 		return errors.Wrap(err, "wrong validation signature")
 	}
 
-	if err := h.storage.AddValidationSignature(payload.RequestID, voterID, payload.ProverID, payload.Signature); err != nil {
+	request, err = h.checkVotedProof(payload)
+	if err != nil {
+		return err
+	}
+
+	key := validationKey{RequestID: payload.RequestID, ProverID: payload.ProverID}
+	votingExists, equivocation := h.validationVotings.Add(key, voterID, payload.IsValid, payload.Signature)
+	if equivocation != nil {
+		// both votes are signed by the voter's ethereum key, so the contract can verify the evidence
+		h.recordEvidence(ctx, common.Evidence{
+			Type:      common.EvidenceEquivocation,
+			Offender:  voterID,
+			RequestID: payload.RequestID,
+			ProverID:  payload.ProverID,
+			ProofHash: payload.ProofHash,
+			Votes: []common.SignedVote{
+				{VoterID: voterID, IsValid: equivocation.First.Value, Signature: equivocation.First.Signature},
+				{VoterID: voterID, IsValid: equivocation.Second.Value, Signature: equivocation.Second.Signature},
+			},
+			SignedMessage: logic.SignedMessage(ctx),
+		})
+
+		return errEquivocation
+	}
+
+	if err := h.storage.AddValidationSignature(payload.RequestID, voterID, payload.ProverID, payload.IsValid, payload.Signature); err != nil {
 		return errors.Wrap(err, "error adding validation signature")
 	}
 
-	votingExists := h.validationVotings.Add(payload.RequestID, voterID, payload.IsValid)
-	if !votingExists && payload.ProverID == h.host.ID() {
-		time.Sleep(ValidationVotingDuration)
-		isProofValid, err := h.validationVotings.GetWinner(payload.RequestID)
-		if err != nil {
-			return errors.Wrap(err, "error getting winner")
-		}
+	if proof, ok := request.Proofs[payload.ProverID]; ok {
+		metrics.ValidationVoteLatency.WithLabelValues(request.ConsumerImage).Observe(time.Since(time.Unix(0, proof.Timestamp)).Seconds())
+	}
+
+	h.events.Publish(common.RequestEvent{
+		RequestID: payload.RequestID,
+		Type:      common.EventValidationVote,
+		PeerID:    voterID,
+		IsValid:   payload.IsValid,
+	})
+
+	if votingExists {
+		return nil
+	}
+
+	// every node tallies the same votes, so every node reaches the same result and moves the request on its own
+	isProofValid, err := h.awaitValidationVotes(ctx, key, request.ConsumerImage)
+	h.validationVotings.Close(key)
+	if errors.Is(err, logic.ErrNoQuorum) || errors.Is(err, logic.ErrNoThreshold) {
+		// the proof isn't accepted without the agreement of the network
+		slog.Warn("validation voting is inconclusive", slog.String("requestID", key.RequestID), slog.String("err", err.Error()))
+
+		return h.handleInvalidProof(ctx, payload.RequestID, payload.ProverID)
+	}
 
-		h.validationVotings.Delete(payload.RequestID)
-		if isProofValid == nil {
-			return errors.New("no validation votes")
+	if err != nil {
+		return errors.Wrap(err, "error getting winner")
+	}
+
+	if isProofValid == nil {
+		return errors.New("no validation votes")
+	}
+
+	if !*isProofValid {
+		if payload.ProverID != h.host.ID() {
+			h.collectInvalidProofEvidence(ctx, payload.RequestID, payload.ProverID)
 		}
 
-		if !*isProofValid {
-			return h.handleInvalidProof(ctx, payload.RequestID)
+		return h.handleInvalidProof(ctx, payload.RequestID, payload.ProverID)
+	}
+
+	return h.finalizeProof(ctx, request, payload.ProverID)
+}
+
+// finalizeProof runs on every node once the proof is accepted, the prover's node submits it to get the reward
+// and lets the others know the submission transaction
+func (h *VotingHandler) finalizeProof(ctx context.Context, request common.RequestExtension, proverID peer.ID) error {
+	// the signatures are taken before the request is archived
+	signatures, err := h.storage.GetValidationSignatures(request.ID, proverID)
+	if err != nil {
+		return errors.Wrap(err, "error getting validation signatures")
+	}
+	proof := common.SignedProof{
+		Request:    request.ProvingRequestMessage,
+		ProverID:   proverID,
+		ProofHash:  common.ProofHash(request.Proofs[proverID].Proof),
+		Signatures: signatures,
+	}
+
+	if err := h.storage.FinalizeRequest(request.ID); err != nil {
+		return errors.Wrap(err, "error finalizing the request")
+	}
+	h.forgetVotings(request)
+
+	h.events.Publish(common.RequestEvent{
+		RequestID: request.ID,
+		Type:      common.EventProofFinalized,
+		PeerID:    proverID,
+		ProofID:   request.Proofs[proverID].ProofID,
+	})
+
+	if proverID != h.host.ID() {
+		return nil
+	}
+
+	// the payout is persisted first, so a restart during the submission doesn't lose the reward
+	if err := h.payouts.Track(request.ProvingRequestMessage); err != nil {
+		slog.Error("error tracking the payout", slog.String("requestID", request.ID), slog.String("err", err.Error()))
+	}
+
+	txHash, err := h.submitter.Submit(ctx, proof)
+	if !errors.Is(err, context.Canceled) {
+		if err := h.payouts.SetSubmitted(request.ID, txHash, err); err != nil {
+			slog.Error("error saving the payout", slog.String("requestID", request.ID), slog.String("err", err.Error()))
 		}
+	}
 
-		signatures, err := h.storage.GetValidationSignatures(payload.RequestID, payload.ProverID)
-		if err != nil {
-			return errors.Wrap(err, "error getting validation signatures")
+	if err != nil {
+		return errors.Wrap(err, "error submitting validation signatures")
+	}
+
+	submitted := common.VotingMessage{
+		Type: common.VoteProofSubmitted,
+		Payload: common.ProofSubmittedPayload{
+			RequestID: request.ID,
+			TxHash:    txHash,
+		},
+	}
+	if err := h.pubsub.Publish(ctx, common.VotingTopic, submitted); err != nil {
+		slog.Error("error announcing the submission", slog.String("requestID", request.ID), slog.String("err", err.Error()))
+	}
+
+	return h.markSubmitted(request.ID, proverID, txHash)
+}
+
+// handleProofSubmitted records the submission announced by the prover of the finalized proof,
+// the pubsub message is signed by the prover, so nobody else can announce it
+func (h *VotingHandler) handleProofSubmitted(_ context.Context, senderID peer.ID, message common.VotingMessage) error {
+	payload, ok := message.Payload.(common.ProofSubmittedPayload)
+	if !ok {
+		return errors.New("invalid payload type for VoteProofSubmitted")
+	}
+
+	if senderID == h.host.ID() {
+		return nil
+	}
+
+	result, err := h.storage.GetFromResultsStorage(payload.RequestID)
+	if err != nil {
+		// the validation voting may still be running here
+		time.Sleep(DoubleCheckInterval)
+		if result, err = h.storage.GetFromResultsStorage(payload.RequestID); err != nil {
+			return errors.Wrap(err, "submission of an unknown proof")
 		}
+	}
 
-		// TODO: optimize by batching the signatures
-		if err := h.ethereum.SubmitValidationSignatures(ctx, request.ProvingRequestMessage, signatures); err != nil {
-			return errors.Wrap(err, "error submitting validation signatures")
+	if result.ProverID != senderID {
+		return errors.New("submission is announced not by the prover")
+	}
+
+	return h.markSubmitted(payload.RequestID, senderID, payload.TxHash)
+}
+
+func (h *VotingHandler) markSubmitted(requestID common.RequestID, proverID peer.ID, txHash string) error {
+	if err := h.storage.MarkSubmitted(requestID, txHash); err != nil {
+		return errors.Wrap(err, "error marking request as submitted")
+	}
+
+	h.events.Publish(common.RequestEvent{
+		RequestID: requestID,
+		Type:      common.EventSubmissionMined,
+		PeerID:    proverID,
+		TxHash:    txHash,
+	})
+
+	return nil
+}
+
+// awaitValidationVotes waits for the validation votes of the other nodes and returns the voting result
+func (h *VotingHandler) awaitValidationVotes(ctx context.Context, key validationKey, consumerImage string) (winner *bool, err error) {
+	ctx, span := tracing.Start(ctx, "VotingHandler.awaitValidationVotes", trace.WithAttributes(tracing.RequestID(key.RequestID)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	return h.validationVotings.Await(ctx, key, h.validationRound(consumerImage, key.ProverID))
+}
+
+// selectionRound is decided by all the reachable nodes, every node votes for the prover it has selected
+// selectionRound takes the candidate with the most votes once the quorum has voted, the nodes select from the
+// capacities they've seen last, so the votes can be split and no candidate has to reach the threshold
+func (h *VotingHandler) selectionRound(consumerImage string) logic.RoundParams {
+	voters := []peer.ID{h.host.ID()}
+	for _, node := range h.nodes.ReachableNodes() {
+		if node.PeerID != h.host.ID() {
+			voters = append(voters, node.PeerID)
 		}
+	}
+
+	params := h.roundParams(consumerImage, voters, h.cfg.SelectionVotingTime)
+	params.Threshold = 0
 
-		if err := h.storage.DeleteProvingRequest(payload.RequestID); err != nil {
-			return errors.Wrap(err, "error finishing proving")
+	return params
+}
+
+// validationRound is decided by the reachable nodes that run the consumer's image, the prover doesn't validate its own proof
+func (h *VotingHandler) validationRound(consumerImage string, proverID peer.ID) logic.RoundParams {
+	voters := make([]peer.ID, 0)
+	for _, node := range h.nodes.ReachableNodes() {
+		if node.PeerID != proverID && slices.Contains(node.Commitments, consumerImage) {
+			voters = append(voters, node.PeerID)
 		}
 	}
 
-	return nil
+	return h.roundParams(consumerImage, voters, h.cfg.ValidationVotingTime)
+}
+
+func (h *VotingHandler) roundParams(consumerImage string, voters []peer.ID, deadline time.Duration) logic.RoundParams {
+	params := h.cfg.VotingParamsFor(consumerImage)
+
+	return logic.RoundParams{
+		Voters:    voters,
+		Quorum:    params.Quorum,
+		Threshold: params.Threshold,
+		Deadline:  deadline,
+		Weight:    h.weigher.Weight,
+	}
+}
+
+// selectionTieBreak prefers the prover with the lower hash of the request and the peer ID,
+// so the ties don't favour the same peers across the requests
+func selectionTieBreak(key selectionKey, a, b peer.ID) bool {
+	return peerHashLess(key.RequestID, a, b)
+}
+
+// peerHashLess orders the peers by the hash of the request and the peer ID
+func peerHashLess(requestID common.RequestID, a, b peer.ID) bool {
+	hashA := sha256.Sum256([]byte(requestID + a.String()))
+	hashB := sha256.Sum256([]byte(requestID + b.String()))
+
+	return bytes.Compare(hashA[:], hashB[:]) < 0
+}
+
+// validationTieBreak rejects the proof on a tie
+func validationTieBreak(_ validationKey, a, b bool) bool {
+	return !a && b
 }
 
 func (h *VotingHandler) checkValidationSignature(voterID peer.ID, payload common.ValidationPayload) error {
-	validatorAddr, err := common.PeerIDToEthAddress(voterID)
+	ok, err := common.IsValidationSignedBy(voterID, payload.RequestID, payload.ProverID, payload.ProofHash, payload.IsValid, payload.Signature)
 	if err != nil {
-		return errors.Wrap(err, "error converting peer ID to eth address")
+		return errors.Wrap(errCantVerifySignature, err.Error())
 	}
 
-	proverAddr, err := common.PeerIDToEthAddress(payload.ProverID)
+	if !ok {
+		return errInvalidSignature
+	}
+
+	return nil
+}
+
+// checkVotedProof checks that the vote is about the proof this node has, a prover could send different proofs
+// to different nodes, so only the votes for the same proof are counted together. The vote can come before the proof
+func (h *VotingHandler) checkVotedProof(payload common.ValidationPayload) (common.RequestExtension, error) {
+	request, err := h.storage.GetProvingRequestByID(payload.RequestID)
 	if err != nil {
-		return errors.Wrap(err, "error converting peer ID to eth address")
+		return common.RequestExtension{}, errors.New("unknown requestID")
+	}
+
+	proof, ok := request.Proofs[payload.ProverID]
+	if !ok {
+		time.Sleep(DoubleCheckInterval)
+		if request, err = h.storage.GetProvingRequestByID(payload.RequestID); err != nil {
+			return common.RequestExtension{}, errors.New("unknown requestID")
+		}
+
+		if proof, ok = request.Proofs[payload.ProverID]; !ok {
+			return common.RequestExtension{}, errors.New("vote for an unknown proof")
+		}
 	}
 
-	dataToSign := common.DataToSign{
-		RequestID:     payload.RequestID,
-		ProverAddress: proverAddr,
-		IsValid:       payload.IsValid,
+	if common.ProofHash(proof.Proof) != payload.ProofHash {
+		return common.RequestExtension{}, errors.Errorf("vote for a different proof: %s", payload.ProofHash)
 	}
 
-	b, err := json.Marshal(dataToSign)
+	return request, nil
+}
+
+// handleInvalidProof runs on every node, so all of them take part in the next selection round
+func (h *VotingHandler) handleInvalidProof(ctx context.Context, requestID common.RequestID, proverID peer.ID) error {
+	return h.reselectProver(ctx, requestID, proverID)
+}
+
+// reselectProver runs the selection again without the provers that already had their attempt,
+// the request fails once there were maxProvingAttempts of them
+func (h *VotingHandler) reselectProver(ctx context.Context, requestID common.RequestID, proverID peer.ID) error {
+	req, err := h.storage.GetProvingRequestByID(requestID)
 	if err != nil {
-		return errors.Wrap(err, "error marshaling a message")
+		return errors.Wrap(err, "error getting proving request")
 	}
 
-	hash := ethCrypto.Keccak256Hash(b)
-	pub, err := ethCrypto.SigToPub(hash.Bytes(), payload.Signature)
+	if len(req.ProvingPeers) < maxProvingAttempts {
+		h.events.Publish(common.RequestEvent{
+			RequestID: requestID,
+			Type:      common.EventProverReselected,
+			PeerID:    proverID,
+		})
+
+		return h.service.HandleProverSelection(ctx, req.ProvingRequestMessage, req.ProvingPeers...)
+	}
+
+	return h.failRequest(ctx, req)
+}
+
+// failRequest is the terminal path of the request, the failure record is kept and optionally reported on-chain
+func (h *VotingHandler) failRequest(ctx context.Context, req common.RequestExtension) error {
+	record, err := h.storage.FailRequest(req.ID)
 	if err != nil {
-		return errors.Wrap(err, "error converting signature to public key")
+		return errors.Wrap(err, "error marking request as failed")
 	}
+	h.forgetVotings(req)
 
-	if !strings.EqualFold(ethCrypto.PubkeyToAddress(*pub).Hex(), validatorAddr[2:]) {
-		return errInvalidSignature
+	// the failed request is never paid, so its reward isn't held anymore
+	if err := h.ledger.Release(req.ID); err != nil {
+		slog.Error("error releasing the reservation", slog.String("requestID", req.ID), slog.String("err", err.Error()))
+	}
+
+	slog.Warn("request failed", slog.String("requestID", req.ID), slog.Int("attempts", len(record.Attempts)))
+	h.events.Publish(common.RequestEvent{
+		RequestID: req.ID,
+		Type:      common.EventRequestFailed,
+	})
+
+	if h.reportFailures {
+		if err := h.reportFailure(ctx, req, record); err != nil {
+			return err
+		}
+	}
+
+	if h.cfg.SubmitEvidence {
+		go h.submitInvalidProofEvidence(ctx, req.ID)
 	}
 
 	return nil
 }
 
-func (h *VotingHandler) handleInvalidProof(ctx context.Context, requestID common.RequestID) error {
+// reportFailure lets a single node report the failed request, the contract accepts only one report. The nodes are ranked
+// by the hash of the request and their peer ID, every node waits for the ones ranked before it and reports only
+// if none of them did, so a reporter that is down or doesn't report the failures is replaced
+func (h *VotingHandler) reportFailure(ctx context.Context, req common.RequestExtension, record common.FailureRecord) error {
+	rank := h.reporterRank(req.ID)
+	if rank > 0 {
+		select {
+		case <-time.After(time.Duration(rank) * h.cfg.FailureReportDelay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		reported, err := h.ethereum.IsRequestFailed(ctx, req.ID)
+		if err != nil {
+			return errors.Wrap(err, "error checking the failure report")
+		}
+
+		if reported {
+			return nil
+		}
+	}
+
+	txHash, err := h.ethereum.ReportFailedRequest(ctx, req.ProvingRequestMessage, record)
+	if errors.Is(err, connectors.ErrNoRejectedProofs) {
+		slog.Info("failed request can't be reported", slog.String("requestID", req.ID), slog.String("err", err.Error()))
+
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error reporting failed request")
+	}
+
+	return errors.Wrap(h.storage.SetFailureReportTx(req.ID, txHash), "error saving failure report")
+}
+
+// submitInvalidProofEvidence lets a single node submit the evidence against the provers of the failed request, the contract
+// slashes only the provers whose proofs the failure report rejected. The nodes are ranked like the failure reporters,
+// every node waits for the ones ranked before it and submits only the evidence none of them did
+func (h *VotingHandler) submitInvalidProofEvidence(ctx context.Context, requestID common.RequestID) {
+	list, err := h.evidence.List(requestID)
+	if err != nil {
+		slog.Error("error listing evidence", slog.String("requestID", requestID), slog.String("err", err.Error()))
+
+		return
+	}
+
+	list = slices.DeleteFunc(list, func(evidence common.Evidence) bool {
+		return evidence.Type != common.EvidenceInvalidProof
+	})
+	if len(list) == 0 {
+		return
+	}
+
+	select {
+	case <-time.After(time.Duration(h.reporterRank(requestID)) * h.cfg.FailureReportDelay):
+	case <-ctx.Done():
+		return
+	}
+
+	failed, err := h.ethereum.IsRequestFailed(ctx, requestID)
+	if err != nil {
+		slog.Error("error checking the failure report", slog.String("requestID", requestID), slog.String("err", err.Error()))
+
+		return
+	}
+
+	if !failed {
+		return
+	}
+
+	for _, evidence := range list {
+		slashed, err := h.ethereum.IsSlashed(ctx, evidence)
+		if err != nil {
+			slog.Error("error checking the slashing", slog.String("requestID", requestID), slog.String("err", err.Error()))
+
+			continue
+		}
+
+		if slashed {
+			continue
+		}
+
+		if _, err := h.evidence.Submit(ctx, evidence.ID); err != nil {
+			slog.Warn("error submitting evidence",
+				slog.String("requestID", requestID),
+				slog.String("offender", evidence.Offender.String()),
+				slog.String("err", err.Error()),
+			)
+		}
+	}
+}
+
+// reporterRank is the number of the reachable nodes that report the failure of the request before this one
+func (h *VotingHandler) reporterRank(requestID common.RequestID) int {
+	rank := 0
+	for _, node := range h.nodes.ReachableNodes() {
+		if node.PeerID != h.host.ID() && peerHashLess(requestID, node.PeerID, h.host.ID()) {
+			rank++
+		}
+	}
+
+	return rank
+}
+
+// forgetVotings drops the votings of the finished request, including the selection that didn't finish
+func (h *VotingHandler) forgetVotings(req common.RequestExtension) {
+	for attempt, proverID := range req.ProvingPeers {
+		h.selectionVotings.Delete(selectionKey{RequestID: req.ID, Attempt: attempt})
+		h.validationVotings.Delete(validationKey{RequestID: req.ID, ProverID: proverID})
+	}
+	h.selectionVotings.Delete(selectionKey{RequestID: req.ID, Attempt: len(req.ProvingPeers)})
+
+	h.declinesMu.Lock()
+	for key := range h.declines {
+		if key.RequestID == req.ID {
+			delete(h.declines, key)
+		}
+	}
+	h.declinesMu.Unlock()
+}
+
+// collectInvalidProofEvidence records the negative votes of the validation round that rejected the proof
+func (h *VotingHandler) collectInvalidProofEvidence(ctx context.Context, requestID common.RequestID, proverID peer.ID) {
 	req, err := h.storage.GetProvingRequestByID(requestID)
 	if err != nil {
-		return errors.Wrap(err, "error getting proving request")
+		return
 	}
 
-	// TODO: punish the node that has submitted the proof
+	votes := make([]common.SignedVote, 0)
+	for voterID, isValid := range req.ValidationVotes[proverID] {
+		if !isValid {
+			votes = append(votes, common.SignedVote{
+				VoterID:   voterID,
+				Signature: req.ValidationSignatures[proverID][voterID],
+			})
+		}
+	}
 
-	if len(req.ProvingPeers) < maxProvingAttempts {
-		return h.service.HandleProverSelection(ctx, req.ProvingRequestMessage, req.ProvingPeers...)
+	if len(votes) < logic.MinInvalidProofVotes {
+		return
 	}
 
-	// TODO: collect signatures for all (3) invalid proofs and send them to the contract
-	// TODO: delete request from storage
+	h.recordEvidence(ctx, common.Evidence{
+		Type:      common.EvidenceInvalidProof,
+		Offender:  proverID,
+		RequestID: requestID,
+		ProverID:  proverID,
+		ProofHash: common.ProofHash(req.Proofs[proverID].Proof),
+		Votes:     votes,
+	})
+}
 
-	return nil
+func (h *VotingHandler) recordEvidence(ctx context.Context, evidence common.Evidence) {
+	if err := h.evidence.Record(ctx, evidence); err != nil {
+		slog.Error("error recording evidence",
+			slog.String("type", evidence.Type.String()),
+			slog.String("requestID", evidence.RequestID),
+			slog.String("err", err.Error()),
+		)
+	}
 }
@@ -0,0 +1,148 @@
+package logic
+
+import (
+	"cmp"
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/pkg/errors"
+	"log/slog"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+)
+
+var ErrQueueFull = errors.New("proving queue is full")
+
+// JobQueue keeps the proofs waiting for a free slot, the most rewarded and then the oldest requests go first.
+// The jobs stay in the storage until they are finished, so the queued and the running ones are resumed after a restart
+type JobQueue struct {
+	storage  *Storage
+	capacity *CapacityMeter
+	size     int
+
+	jobs    []common.ProvingJob
+	changed chan struct{} // closed when a job is pushed
+	mu      sync.Mutex
+}
+
+func NewJobQueue(cfg *common.Config, storage *Storage, capacity *CapacityMeter) (*JobQueue, error) {
+	q := &JobQueue{
+		storage:  storage,
+		capacity: capacity,
+		size:     cfg.JobQueueSize,
+		changed:  make(chan struct{}),
+	}
+
+	jobs, err := storage.ListJobs()
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading the proving jobs")
+	}
+
+	for _, job := range jobs {
+		// the request was finished or failed while the node was down
+		if !storage.HasRequest(job.Request.ID) {
+			if err := storage.DeleteJob(job.Request.ID); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		q.jobs = append(q.jobs, job)
+	}
+	slices.SortStableFunc(q.jobs, compareJobs)
+
+	if len(q.jobs) > 0 {
+		slog.Info("resuming proving jobs", slog.Int("count", len(q.jobs)))
+	}
+	q.capacity.SetQueue(len(q.jobs), q.size)
+
+	return q, nil
+}
+
+func (q *JobQueue) Push(job common.ProvingJob) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.jobs) >= q.size {
+		return ErrQueueFull
+	}
+
+	job.EnqueuedAt = time.Now().UnixNano()
+	if err := q.storage.SaveJob(job); err != nil {
+		return err
+	}
+
+	q.jobs = append(q.jobs, job)
+	slices.SortStableFunc(q.jobs, compareJobs)
+	q.capacity.SetQueue(len(q.jobs), q.size)
+
+	close(q.changed)
+	q.changed = make(chan struct{})
+
+	return nil
+}
+
+// Next removes the first job, in the priority order, that take accepts, take starts the job
+func (q *JobQueue) Next(take func(common.ProvingJob) bool) (common.ProvingJob, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, job := range q.jobs {
+		if take(job) {
+			q.jobs = slices.Delete(q.jobs, i, i+1)
+			q.capacity.SetQueue(len(q.jobs), q.size)
+
+			return job, true
+		}
+	}
+
+	return common.ProvingJob{}, false
+}
+
+// Changed returns a channel that is closed once a job is pushed
+func (q *JobQueue) Changed() <-chan struct{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.changed
+}
+
+// Remove drops the queued job of the request, the running one is dropped by the worker
+func (q *JobQueue) Remove(requestID common.RequestID) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	idx := slices.IndexFunc(q.jobs, func(job common.ProvingJob) bool {
+		return job.Request.ID == requestID
+	})
+	if idx < 0 {
+		return nil
+	}
+
+	q.jobs = slices.Delete(q.jobs, idx, idx+1)
+	q.capacity.SetQueue(len(q.jobs), q.size)
+
+	return q.storage.DeleteJob(requestID)
+}
+
+// Done forgets the finished job
+func (q *JobQueue) Done(requestID common.RequestID) error {
+	return q.storage.DeleteJob(requestID)
+}
+
+func compareJobs(a, b common.ProvingJob) int {
+	if c := reward(b).Cmp(reward(a)); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(a.Request.Timestamp, b.Request.Timestamp)
+}
+
+func reward(job common.ProvingJob) *big.Int {
+	if job.Request.Reward == nil {
+		return new(big.Int)
+	}
+
+	return job.Request.Reward
+}
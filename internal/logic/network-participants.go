@@ -5,24 +5,30 @@ import (
 	"github.com/dimazhornyk/generic-proving-network/internal/common"
 	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
+	"log/slog"
+	"math/big"
 	"sync"
+	"time"
 )
 
 type NetworkParticipants struct {
 	sync.Mutex
 
-	eth       *connectors.Ethereum
-	provers   map[ethcommon.Address]struct{}
-	consumers map[ethcommon.Address]common.Consumer
+	eth             *connectors.Ethereum
+	provers         map[ethcommon.Address]*big.Int // stake in wei
+	consumers       map[ethcommon.Address]common.Consumer
+	refreshInterval time.Duration
 }
 
-func NewNetworkParticipants(ctx context.Context, eth *connectors.Ethereum) (*NetworkParticipants, error) {
+func NewNetworkParticipants(ctx context.Context, cfg *common.Config, eth *connectors.Ethereum) (*NetworkParticipants, error) {
 	np := &NetworkParticipants{
-		eth:       eth,
-		provers:   make(map[ethcommon.Address]struct{}),
-		consumers: make(map[ethcommon.Address]common.Consumer),
+		eth:             eth,
+		provers:         make(map[ethcommon.Address]*big.Int),
+		refreshInterval: cfg.StakeRefreshInterval,
+		consumers:       make(map[ethcommon.Address]common.Consumer),
 	}
 
 	eg := errgroup.Group{}
@@ -42,11 +48,7 @@ func (np *NetworkParticipants) GetConsumers(ctx context.Context) error {
 		return errors.Wrap(err, "error getting consumers from ethereum")
 	}
 
-	np.Lock()
-	for _, consumer := range consumers {
-		np.consumers[consumer.Address] = consumer
-	}
-	np.Unlock()
+	np.setConsumers(consumers)
 
 	ch, err := np.eth.ListenForNewConsumers(ctx)
 	if err != nil {
@@ -77,17 +79,42 @@ func (np *NetworkParticipants) GetConsumers(ctx context.Context) error {
 	return nil
 }
 
+func (np *NetworkParticipants) refreshConsumers(ctx context.Context) error {
+	consumers, err := np.eth.GetAllConsumers(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error getting consumers from ethereum")
+	}
+
+	np.setConsumers(consumers)
+
+	return nil
+}
+
+// setConsumers skips the withdrawn consumers, the contract keeps their addresses with an empty record
+func (np *NetworkParticipants) setConsumers(consumers []common.Consumer) {
+	np.Lock()
+	defer np.Unlock()
+
+	for _, consumer := range consumers {
+		if consumer.Image == "" {
+			delete(np.consumers, consumer.Address)
+
+			continue
+		}
+
+		np.consumers[consumer.Address] = consumer
+	}
+}
+
 func (np *NetworkParticipants) GetProvers(ctx context.Context) error {
 	addrs, err := np.eth.GetAllProvers(ctx)
 	if err != nil {
 		return errors.Wrap(err, "error getting provers from ethereum")
 	}
 
-	np.Lock()
-	for _, addr := range addrs {
-		np.provers[addr] = struct{}{}
+	if err := np.refreshStakes(ctx, addrs); err != nil {
+		return err
 	}
-	np.Unlock()
 
 	ch, err := np.eth.ListenForNewProvers(ctx)
 	if err != nil {
@@ -98,19 +125,80 @@ func (np *NetworkParticipants) GetProvers(ctx context.Context) error {
 		for {
 			select {
 			case msg := <-ch:
-				np.Lock()
-				if msg.IsAdded {
-					np.provers[msg.Addr] = struct{}{}
-				} else {
+				if !msg.IsAdded {
+					np.Lock()
 					delete(np.provers, msg.Addr)
+					np.Unlock()
+
+					continue
+				}
+
+				// the event doesn't carry the deposit, so it's read from the contract
+				if err := np.refreshStakes(ctx, []ethcommon.Address{msg.Addr}); err != nil {
+					slog.Error("error getting the stake of a new prover", slog.String("err", err.Error()))
 				}
-				np.Unlock()
 			case <-ctx.Done():
 				return
 			}
 		}
 	}()
 
+	go np.stakesRefresher(ctx)
+
+	return nil
+}
+
+// stakesRefresher re-reads the stakes and the consumer balances, since slashing, rewards, deposits and withdrawals
+// change them without the update events
+func (np *NetworkParticipants) stakesRefresher(ctx context.Context) {
+	ticker := time.NewTicker(np.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			addrs, err := np.eth.GetAllProvers(ctx)
+			if err != nil {
+				slog.Error("error getting provers from ethereum", slog.String("err", err.Error()))
+
+				continue
+			}
+
+			if err := np.refreshStakes(ctx, addrs); err != nil {
+				slog.Error("error refreshing the provers stakes", slog.String("err", err.Error()))
+			}
+
+			if err := np.refreshConsumers(ctx); err != nil {
+				slog.Error("error refreshing the consumers balances", slog.String("err", err.Error()))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// refreshStakes drops the provers with zero stake, the contract treats them as not registered
+func (np *NetworkParticipants) refreshStakes(ctx context.Context, addrs []ethcommon.Address) error {
+	stakes := make(map[ethcommon.Address]*big.Int, len(addrs))
+	for _, addr := range addrs {
+		stake, err := np.eth.GetProverStake(ctx, addr)
+		if err != nil {
+			return errors.Wrapf(err, "error getting the stake of %s", addr.Hex())
+		}
+
+		stakes[addr] = stake
+	}
+
+	np.Lock()
+	for addr, stake := range stakes {
+		if stake.Sign() == 0 {
+			delete(np.provers, addr)
+		} else {
+			np.provers[addr] = stake
+		}
+	}
+	np.Unlock()
+
 	return nil
 }
 
@@ -123,6 +211,29 @@ func (np *NetworkParticipants) IsKnownProver(addr ethcommon.Address) bool {
 	return ok
 }
 
+// GetStake returns the prover's deposit in wei, zero for the unknown provers
+func (np *NetworkParticipants) GetStake(addr ethcommon.Address) *big.Int {
+	np.Lock()
+	defer np.Unlock()
+
+	stake, ok := np.provers[addr]
+	if !ok {
+		return new(big.Int)
+	}
+
+	return new(big.Int).Set(stake)
+}
+
+// IsRegisteredPeer checks that the ethereum address derived from the peer's public key is a registered prover
+func (np *NetworkParticipants) IsRegisteredPeer(peerID peer.ID) (bool, error) {
+	addr, err := common.PeerIDToEthAddress(peerID)
+	if err != nil {
+		return false, errors.Wrap(err, "error converting peer ID to ethereum address")
+	}
+
+	return np.IsKnownProver(ethcommon.HexToAddress(addr)), nil
+}
+
 func (np *NetworkParticipants) IsKnownConsumer(addr ethcommon.Address) bool {
 	np.Lock()
 	defer np.Unlock()
@@ -132,6 +243,15 @@ func (np *NetworkParticipants) IsKnownConsumer(addr ethcommon.Address) bool {
 	return ok
 }
 
+func (np *NetworkParticipants) GetConsumer(addr ethcommon.Address) (common.Consumer, bool) {
+	np.Lock()
+	defer np.Unlock()
+
+	consumer, ok := np.consumers[addr]
+
+	return consumer, ok
+}
+
 func (np *NetworkParticipants) GetAllConsumers() []common.Consumer {
 	np.Lock()
 	defer np.Unlock()
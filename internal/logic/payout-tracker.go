@@ -0,0 +1,236 @@
+package logic
+
+import (
+	"context"
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
+	"github.com/pkg/errors"
+	"log/slog"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// PayoutTracker follows the rewards of the proofs submitted by this node. The records are persisted before the
+// submission, so the claims that weren't made before a restart are made after it. Once the block time passes the claim
+// window of the request, the reward is claimed with a contract call, unless AutoClaim is off
+type PayoutTracker struct {
+	storage   *Storage
+	ethereum  *connectors.Ethereum
+	autoClaim bool
+	interval  time.Duration
+
+	blockTime atomic.Int64 // the block time of the last check, the claim windows are compared with it
+	claiming  map[common.RequestID]struct{}
+	mu        sync.Mutex
+}
+
+func NewPayoutTracker(cfg *common.Config, storage *Storage, eth *connectors.Ethereum) *PayoutTracker {
+	return &PayoutTracker{
+		storage:   storage,
+		ethereum:  eth,
+		autoClaim: cfg.AutoClaim,
+		interval:  cfg.PayoutCheckInterval,
+		claiming:  make(map[common.RequestID]struct{}),
+	}
+}
+
+// Track starts following the reward of the request before it's submitted, the outbox keeps the submission over
+// a restart, so the reward is claimed once the contract has the payout even if the transaction hash is never known
+func (t *PayoutTracker) Track(request common.ProvingRequestMessage) error {
+	if request.Reward == nil || request.Reward.Sign() == 0 {
+		return nil
+	}
+
+	return t.storage.SavePayout(common.PayoutRecord{
+		RequestID:       request.ID,
+		ConsumerAddress: request.ConsumerAddress,
+		Reward:          request.Reward,
+		SubmittedAt:     time.Now().Unix(),
+		State:           common.PayoutPending,
+	})
+}
+
+// SetSubmitted records the transaction that submitted the request, or the submission error
+func (t *PayoutTracker) SetSubmitted(requestID common.RequestID, txHash string, submitErr error) error {
+	record, err := t.storage.GetPayout(requestID)
+	if errors.Is(err, connectors.ErrKeyNotFound) {
+		// the request had no reward to track
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	record.SubmissionTx = txHash
+	if submitErr != nil {
+		record.State = common.PayoutFailed
+		record.LastError = submitErr.Error()
+	}
+
+	return t.storage.SavePayout(record)
+}
+
+func (t *PayoutTracker) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := t.check(ctx); err != nil {
+			slog.Error("error checking the payouts", slog.String("err", err.Error()))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (t *PayoutTracker) check(ctx context.Context) error {
+	records, err := t.storage.ListPayouts()
+	if err != nil {
+		return err
+	}
+
+	now, err := t.ethereum.BlockTime(ctx)
+	if err != nil {
+		return err
+	}
+	t.blockTime.Store(now)
+
+	for _, record := range records {
+		switch record.State {
+		case common.PayoutPending:
+			if record.ClaimableAfter == 0 {
+				err := t.loadClaimWindow(ctx, &record)
+				if errors.Is(err, connectors.ErrNoPayout) && record.SubmissionTx == "" {
+					// the submission isn't mined yet
+					continue
+				}
+
+				if err != nil {
+					slog.Warn("error reading the payout", slog.String("requestID", record.RequestID), slog.String("err", err.Error()))
+
+					continue
+				}
+			}
+
+			if !t.autoClaim || record.State != common.PayoutPending || now < record.ClaimableAfter {
+				continue
+			}
+
+			record.State = common.PayoutClaiming
+			if err := t.storage.SavePayout(record); err != nil {
+				slog.Error("error saving the payout", slog.String("requestID", record.RequestID), slog.String("err", err.Error()))
+
+				continue
+			}
+
+			t.startClaim(ctx, record)
+		case common.PayoutClaiming:
+			// the claims left from the previous run, the outbox returns the result of the mined call or sends it again
+			t.startClaim(ctx, record)
+		}
+	}
+
+	return nil
+}
+
+// loadClaimWindow reads the end of the claim window, which is known once the submission is mined
+func (t *PayoutTracker) loadClaimWindow(ctx context.Context, record *common.PayoutRecord) error {
+	claimableAfter, claimed, err := t.ethereum.GetPayout(ctx, record.RequestID)
+	if err != nil {
+		return err
+	}
+
+	record.ClaimableAfter = claimableAfter
+	if claimed {
+		record.State = common.PayoutClaimed
+	}
+
+	return t.storage.SavePayout(*record)
+}
+
+func (t *PayoutTracker) startClaim(ctx context.Context, record common.PayoutRecord) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.claiming[record.RequestID]; ok {
+		return
+	}
+	t.claiming[record.RequestID] = struct{}{}
+
+	go t.claim(ctx, record)
+}
+
+func (t *PayoutTracker) claim(ctx context.Context, record common.PayoutRecord) {
+	defer func() {
+		t.mu.Lock()
+		delete(t.claiming, record.RequestID)
+		t.mu.Unlock()
+	}()
+
+	txHash, err := t.ethereum.ClaimReward(ctx, record.RequestID)
+	switch {
+	case err == nil:
+		slog.Info("claimed the reward", slog.String("requestID", record.RequestID), slog.String("tx", txHash))
+		record.State = common.PayoutClaimed
+		record.ClaimTx = txHash
+		record.LastError = ""
+	case errors.Is(err, connectors.ErrTxReverted) || errors.Is(err, connectors.ErrCallFailed):
+		slog.Error("reward claim failed", slog.String("requestID", record.RequestID), slog.String("err", err.Error()))
+		record.State = common.PayoutFailed
+		record.ClaimTx = txHash
+		record.LastError = err.Error()
+	default:
+		// the claim stays in the outbox, its result is picked up on the next check
+		return
+	}
+
+	if err := t.storage.SavePayout(record); err != nil {
+		slog.Error("error saving the payout", slog.String("requestID", record.RequestID), slog.String("err", err.Error()))
+	}
+}
+
+// Earnings returns the rewards of the tracked requests by consumer. The rewards are the ones from the requests,
+// the contract pays out less when the consumer's balance runs out. The claim windows are compared with the block time
+// of the last check, the same one the claims are made on
+func (t *PayoutTracker) Earnings() ([]common.Earnings, error) {
+	records, err := t.storage.ListPayouts()
+	if err != nil {
+		return nil, err
+	}
+
+	now := t.blockTime.Load()
+	res := make([]common.Earnings, 0)
+	idx := make(map[string]int)
+	for _, record := range records {
+		i, ok := idx[record.ConsumerAddress]
+		if !ok {
+			i = len(res)
+			idx[record.ConsumerAddress] = i
+			res = append(res, common.Earnings{
+				ConsumerAddress: record.ConsumerAddress,
+				Expected:        new(big.Int),
+				Claimable:       new(big.Int),
+				Claimed:         new(big.Int),
+			})
+		}
+
+		switch {
+		case record.State == common.PayoutClaimed:
+			res[i].Claimed.Add(res[i].Claimed, record.Reward)
+		case record.State == common.PayoutFailed:
+		case record.ClaimableAfter == 0 || now < record.ClaimableAfter:
+			res[i].Expected.Add(res[i].Expected, record.Reward)
+		default:
+			res[i].Claimable.Add(res[i].Claimable, record.Reward)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,229 @@
+package logic
+
+import (
+	"context"
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
+	"github.com/dimazhornyk/generic-proving-network/proto"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+	"io"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+const lookupTimeout = time.Second * 5
+const maxLookupRequestSize = 1 << 10
+const maxLookupResponseSize = 64 << 20
+
+// minLookupValidators is the number of the distinct registered validators that have to accept the fetched proof,
+// the same number of votes the contract needs to take the proof as rejected by the network
+const minLookupValidators = MinInvalidProofVotes
+
+// ProofLookup fetches the finalized proofs from the other nodes, when this node doesn't have them
+type ProofLookup struct {
+	host                host.Host
+	protocolID          core.ProtocolID
+	storage             *Storage
+	nodes               *StatusMap
+	networkParticipants *NetworkParticipants
+}
+
+func NewProofLookup(cfg *common.Config, host host.Host, storage *Storage, nodes *StatusMap, np *NetworkParticipants) *ProofLookup {
+	return &ProofLookup{
+		host:                host,
+		protocolID:          cfg.LookupProtocolID,
+		storage:             storage,
+		nodes:               nodes,
+		networkParticipants: np,
+	}
+}
+
+// ProvideProofs answers the lookups of the other nodes from the local results storage
+func (pl *ProofLookup) ProvideProofs() {
+	pl.host.SetStreamHandler(pl.protocolID, func(stream network.Stream) {
+		defer stream.Close()
+
+		if err := pl.answerLookup(stream); err != nil {
+			slog.Error("error answering proof lookup",
+				slog.String("peerID", stream.Conn().RemotePeer().String()),
+				slog.String("err", err.Error()),
+			)
+		}
+	})
+}
+
+// FetchProof asks the nodes committed to the consumer one by one, an empty consumer image means asking all known nodes
+func (pl *ProofLookup) FetchProof(ctx context.Context, requestID common.RequestID, consumerImage string) (common.FinalizedProof, error) {
+	for _, p := range pl.candidatePeers(consumerImage) {
+		result, err := pl.requestProof(ctx, p, requestID)
+		if err != nil {
+			slog.Warn("proof lookup failed", slog.String("peerID", p.String()), slog.String("err", err.Error()))
+
+			continue
+		}
+
+		if err := pl.verifyFinalizedProof(requestID, result); err != nil {
+			slog.Warn("peer returned an unverifiable proof", slog.String("peerID", p.String()), slog.String("err", err.Error()))
+
+			continue
+		}
+
+		return result, nil
+	}
+
+	return common.FinalizedProof{}, ErrNoProof
+}
+
+func (pl *ProofLookup) answerLookup(stream network.Stream) error {
+	b, err := io.ReadAll(io.LimitReader(stream, maxLookupRequestSize))
+	if err != nil {
+		return errors.Wrap(err, "error reading lookup request")
+	}
+
+	var req proto.ProofLookupRequest
+	if err := decodeLookupMessage(stream, b, proto.MessageType_MESSAGE_TYPE_PROOF_LOOKUP_REQUEST, &req); err != nil {
+		return errors.Wrap(err, "error decoding lookup request")
+	}
+
+	var resp proto.ProofLookupResponse
+	if result, err := pl.storage.GetFromResultsStorage(req.GetRequestId()); err == nil {
+		resp.Found = true
+		resp.Result = common.FinalizedProofToProto(result)
+	}
+
+	b, err = connectors.EncodeEnvelope(context.Background(), proto.MessageType_MESSAGE_TYPE_PROOF_LOOKUP_RESPONSE, pl.host.ID(), &resp)
+	if err != nil {
+		return errors.Wrap(err, "error encoding lookup response")
+	}
+
+	if _, err := stream.Write(b); err != nil {
+		return errors.Wrap(err, "error writing lookup response")
+	}
+
+	return nil
+}
+
+func (pl *ProofLookup) requestProof(ctx context.Context, p peer.ID, requestID common.RequestID) (common.FinalizedProof, error) {
+	ctx, cancel := context.WithTimeout(ctx, lookupTimeout)
+	defer cancel()
+
+	stream, err := pl.host.NewStream(ctx, p, pl.protocolID)
+	if err != nil {
+		return common.FinalizedProof{}, errors.Wrap(err, "error on creating a new stream")
+	}
+	defer stream.Close()
+
+	if err := stream.SetDeadline(time.Now().Add(lookupTimeout)); err != nil {
+		return common.FinalizedProof{}, errors.Wrap(err, "error setting stream deadline")
+	}
+
+	b, err := connectors.EncodeEnvelope(ctx, proto.MessageType_MESSAGE_TYPE_PROOF_LOOKUP_REQUEST, pl.host.ID(), &proto.ProofLookupRequest{RequestId: requestID})
+	if err != nil {
+		return common.FinalizedProof{}, errors.Wrap(err, "error encoding lookup request")
+	}
+
+	if _, err := stream.Write(b); err != nil {
+		return common.FinalizedProof{}, errors.Wrap(err, "error writing lookup request")
+	}
+
+	if err := stream.CloseWrite(); err != nil {
+		return common.FinalizedProof{}, errors.Wrap(err, "error closing the stream for writing")
+	}
+
+	b, err = io.ReadAll(io.LimitReader(stream, maxLookupResponseSize))
+	if err != nil {
+		return common.FinalizedProof{}, errors.Wrap(err, "error reading lookup response")
+	}
+
+	var resp proto.ProofLookupResponse
+	if err := decodeLookupMessage(stream, b, proto.MessageType_MESSAGE_TYPE_PROOF_LOOKUP_RESPONSE, &resp); err != nil {
+		return common.FinalizedProof{}, errors.Wrap(err, "error decoding lookup response")
+	}
+
+	if !resp.GetFound() {
+		return common.FinalizedProof{}, ErrNoProof
+	}
+
+	return common.FinalizedProofFromProto(resp.GetResult())
+}
+
+// decodeLookupMessage checks that the envelope is of the expected type and is sent by the stream's peer
+func decodeLookupMessage(stream network.Stream, data []byte, expected proto.MessageType, dest protobuf.Message) error {
+	_, envelope, err := connectors.DecodeEnvelope(context.Background(), data)
+	if err != nil {
+		return err
+	}
+
+	if envelope.GetType() != expected {
+		return errors.Errorf("unexpected message type: %s", envelope.GetType().String())
+	}
+
+	if envelope.GetSender() != stream.Conn().RemotePeer().String() {
+		return errors.Wrapf(connectors.ErrSenderMismatch, "sender %s", envelope.GetSender())
+	}
+
+	return protobuf.Unmarshal(envelope.GetPayload(), dest)
+}
+
+// verifyFinalizedProof checks that this very proof was accepted by a quorum of the registered provers,
+// the validation signatures cover the hash of the proof, so they can't be attached to another one
+func (pl *ProofLookup) verifyFinalizedProof(requestID common.RequestID, result common.FinalizedProof) error {
+	if result.RequestID != requestID {
+		return errors.Errorf("wrong request ID: %s", result.RequestID)
+	}
+
+	proofHash := common.ProofHash(result.Proof.Proof)
+	validators := make(map[ethcommon.Address]struct{}, len(result.ValidationSignatures))
+	for voterID, signature := range result.ValidationSignatures {
+		if voterID == result.ProverID {
+			return errors.New("prover has validated its own proof")
+		}
+
+		ok, err := common.IsValidationSignedBy(voterID, requestID, result.ProverID, proofHash, true, signature)
+		if err != nil {
+			return errors.Wrap(err, "error verifying validation signature")
+		}
+
+		if !ok {
+			return errors.Errorf("invalid validation signature of %s", voterID.String())
+		}
+
+		addr, err := common.PeerIDToEthAddress(voterID)
+		if err != nil {
+			return errors.Wrap(err, "error converting peer ID to eth address")
+		}
+
+		if !pl.networkParticipants.IsKnownProver(ethcommon.HexToAddress(addr)) {
+			return errors.Errorf("validator %s is not a network participant", voterID.String())
+		}
+		validators[ethcommon.HexToAddress(addr)] = struct{}{}
+	}
+
+	if len(validators) < minLookupValidators {
+		return errors.Errorf("proof is accepted by %d validators, %d required", len(validators), minLookupValidators)
+	}
+
+	return nil
+}
+
+func (pl *ProofLookup) candidatePeers(consumerImage string) []peer.ID {
+	peers := make([]peer.ID, 0)
+	for _, node := range pl.nodes.ReachableNodes() {
+		if node.PeerID == pl.host.ID() {
+			continue
+		}
+
+		if consumerImage == "" || slices.Contains(node.Commitments, consumerImage) {
+			peers = append(peers, node.PeerID)
+		}
+	}
+
+	return peers
+}
@@ -0,0 +1,194 @@
+package logic
+
+import (
+	"context"
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
+	"github.com/pkg/errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+type submissionResult struct {
+	txHash string
+	err    error
+}
+
+type pendingProof struct {
+	proof common.SignedProof
+	done  chan submissionResult
+}
+
+// ProofSubmitter collects the finalized requests for SubmitBatchWindow or until there are SubmitBatchSize of them,
+// and submits them in a single transaction. A reverted batch is submitted again request by request,
+// so a single rejected request doesn't cost the others their rewards. The requests are persisted until they're submitted,
+// the ones waiting for their batch when the node stops are submitted after the restart
+type ProofSubmitter struct {
+	ctx      context.Context
+	storage  *Storage
+	ethereum *connectors.Ethereum
+	payouts  *PayoutTracker
+	size     int
+	window   time.Duration
+
+	pending []pendingProof
+	timer   *time.Timer
+	mu      sync.Mutex
+}
+
+func NewProofSubmitter(ctx context.Context, cfg *common.Config, storage *Storage, eth *connectors.Ethereum, payouts *PayoutTracker) *ProofSubmitter {
+	return &ProofSubmitter{
+		ctx:      ctx,
+		storage:  storage,
+		ethereum: eth,
+		payouts:  payouts,
+		size:     cfg.SubmitBatchSize,
+		window:   cfg.SubmitBatchWindow,
+	}
+}
+
+// Submit adds the request to the current batch and returns the hash of the transaction that submitted it
+func (s *ProofSubmitter) Submit(ctx context.Context, proof common.SignedProof) (string, error) {
+	if err := s.storage.SavePendingProof(proof); err != nil {
+		return "", err
+	}
+
+	done := s.enqueue(proof)
+
+	select {
+	case res := <-done:
+		return res.txHash, res.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// Resume submits the requests left pending by the previous run and records the submissions in their payouts
+func (s *ProofSubmitter) Resume(ctx context.Context) {
+	proofs, err := s.storage.ListPendingProofs()
+	if err != nil {
+		slog.Error("error loading the pending proofs", slog.String("err", err.Error()))
+
+		return
+	}
+
+	for _, proof := range proofs {
+		requestID := proof.Request.ID
+
+		// the batch could be mined before the node stopped, the outbox doesn't know about the new one
+		if _, _, err := s.ethereum.GetPayout(ctx, requestID); err == nil {
+			if err := s.storage.DeletePendingProof(requestID); err != nil {
+				slog.Error("error deleting the pending proof", slog.String("requestID", requestID), slog.String("err", err.Error()))
+			}
+
+			continue
+		}
+
+		slog.Info("resubmitting a pending proof", slog.String("requestID", requestID))
+		done := s.enqueue(proof)
+
+		go func() {
+			res := <-done
+			if errors.Is(res.err, context.Canceled) {
+				return
+			}
+
+			if err := s.payouts.SetSubmitted(requestID, res.txHash, res.err); err != nil {
+				slog.Error("error saving the payout", slog.String("requestID", requestID), slog.String("err", err.Error()))
+			}
+		}()
+	}
+}
+
+func (s *ProofSubmitter) enqueue(proof common.SignedProof) chan submissionResult {
+	done := make(chan submissionResult, 1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending = append(s.pending, pendingProof{
+		proof: proof,
+		done:  done,
+	})
+
+	switch {
+	case len(s.pending) >= s.size || s.window == 0:
+		s.flushLocked()
+	case s.timer == nil:
+		s.timer = time.AfterFunc(s.window, s.flush)
+	}
+
+	return done
+}
+
+func (s *ProofSubmitter) flush() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.flushLocked()
+}
+
+func (s *ProofSubmitter) flushLocked() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+
+	if len(s.pending) == 0 {
+		return
+	}
+
+	// the submission outlives the callers, the outbox keeps it anyway
+	go s.submit(s.pending)
+	s.pending = nil
+}
+
+func (s *ProofSubmitter) submit(batch []pendingProof) {
+	if len(batch) > 1 {
+		proofs := common.Map(batch, func(p pendingProof) common.SignedProof {
+			return p.proof
+		})
+
+		txHash, err := s.ethereum.SubmitSignedProofs(s.ctx, proofs)
+		if err == nil {
+			slog.Info("submitted a batch of proofs", slog.Int("size", len(batch)), slog.String("tx", txHash))
+			for _, p := range batch {
+				s.finish(p, submissionResult{txHash: txHash})
+			}
+
+			return
+		}
+
+		if errors.Is(err, context.Canceled) {
+			for _, p := range batch {
+				s.finish(p, submissionResult{err: err})
+			}
+
+			return
+		}
+
+		slog.Warn("batch submission failed, submitting the requests one by one",
+			slog.Int("size", len(batch)),
+			slog.String("err", err.Error()),
+		)
+	}
+
+	for _, p := range batch {
+		go func(p pendingProof) {
+			txHash, err := s.ethereum.SubmitValidationSignatures(s.ctx, p.proof)
+			s.finish(p, submissionResult{txHash: txHash, err: err})
+		}(p)
+	}
+}
+
+// finish drops the persisted request once the outbox is done with it, the canceled ones are resumed after the restart
+func (s *ProofSubmitter) finish(p pendingProof, res submissionResult) {
+	if !errors.Is(res.err, context.Canceled) {
+		if err := s.storage.DeletePendingProof(p.proof.Request.ID); err != nil {
+			slog.Error("error deleting the pending proof", slog.String("requestID", p.proof.Request.ID), slog.String("err", err.Error()))
+		}
+	}
+
+	p.done <- res
+}
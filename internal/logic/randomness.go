@@ -0,0 +1,326 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const secretSize = 32
+
+var errNoReveals = errors.New("no valid reveals")
+
+// RandomnessSource provides the inputs of the prover selection, every honest node has to get the same ones
+// for the same request, and none of the nodes, including the one that created the request, can predict them
+type RandomnessSource interface {
+	Name() string
+	Contributions(ctx context.Context, req common.ProvingRequestMessage, attempt int) ([]common.RandomnessContribution, error)
+}
+
+func NewRandomnessSource(cfg *common.Config, eth *connectors.Ethereum, commitReveal *CommitReveal) RandomnessSource {
+	if cfg.RandomnessSource == common.RandomnessCommitReveal {
+		return commitReveal
+	}
+
+	return &BlockHashRandomness{
+		eth:   eth,
+		delay: cfg.RandomnessBlockDelay,
+	}
+}
+
+// BlockHashRandomness takes prevrandao of the block mined delay blocks after the first block after the request,
+// so the request author can't know it in advance
+type BlockHashRandomness struct {
+	eth   *connectors.Ethereum
+	delay uint64
+}
+
+func (b *BlockHashRandomness) Name() string {
+	return common.RandomnessBlockHash
+}
+
+func (b *BlockHashRandomness) Contributions(ctx context.Context, req common.ProvingRequestMessage, _ int) ([]common.RandomnessContribution, error) {
+	first, err := b.eth.FirstBlockAfter(ctx, uint64(time.Unix(0, req.Timestamp).Unix()))
+	if err != nil {
+		return nil, errors.Wrap(err, "error finding the block after the request")
+	}
+
+	header, err := b.eth.WaitForBlock(ctx, first+b.delay)
+	if err != nil {
+		return nil, errors.Wrap(err, "error waiting for the randomness block")
+	}
+
+	// pre-merge chains don't have prevrandao, the block hash is used there
+	value := header.MixDigest
+	if value == (ethcommon.Hash{}) {
+		value = header.Hash()
+	}
+
+	return []common.RandomnessContribution{{
+		Source: strconv.FormatUint(header.Number.Uint64(), 10),
+		Value:  value.Bytes(),
+	}}, nil
+}
+
+type randomnessKey struct {
+	RequestID common.RequestID
+	Attempt   int
+}
+
+type commitRevealRound struct {
+	commits   map[peer.ID][]byte
+	reveals   map[peer.ID]common.RandomnessMessage
+	frozen    bool // the commit window is over, the late commits are ignored
+	changed   chan struct{}
+	createdAt time.Time
+}
+
+// CommitReveal mixes the secrets of the nodes committed to the consumer, every node publishes the hash of its secret
+// first and reveals the secret after the commit window, so nobody knows the others' secrets while choosing its own.
+// The commit windows of the nodes don't end at the same time, so every reveal carries the commitments its author got
+// in time, and the contributors are the committers listed by the majority of the reveals. A contributor that doesn't
+// reveal is taken with its commitment and can't be selected, so withholding the secret doesn't let it select itself
+type CommitReveal struct {
+	host        host.Host
+	pubsub      *connectors.PubSub
+	window      time.Duration
+	commitments []string
+
+	rounds map[randomnessKey]*commitRevealRound
+	mu     sync.Mutex
+}
+
+func NewCommitReveal(cfg *common.Config, host host.Host, pubsub *connectors.PubSub) *CommitReveal {
+	return &CommitReveal{
+		host:        host,
+		pubsub:      pubsub,
+		window:      cfg.CommitRevealWindow,
+		commitments: cfg.Consumers,
+		rounds:      make(map[randomnessKey]*commitRevealRound),
+	}
+}
+
+func (c *CommitReveal) Name() string {
+	return common.RandomnessCommitReveal
+}
+
+func (c *CommitReveal) Contributions(ctx context.Context, req common.ProvingRequestMessage, attempt int) ([]common.RandomnessContribution, error) {
+	key := randomnessKey{RequestID: req.ID, Attempt: attempt}
+	round := c.round(key)
+
+	var secret []byte
+	if slices.Contains(c.commitments, req.ConsumerImage) {
+		secret = make([]byte, secretSize)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, errors.Wrap(err, "error generating a secret")
+		}
+
+		if err := c.publish(ctx, key, common.RandomnessCommit, commitment(c.host.ID(), secret), nil); err != nil {
+			return nil, errors.Wrap(err, "error publishing the commitment")
+		}
+	}
+
+	if err := sleepCtx(ctx, c.window); err != nil {
+		return nil, err
+	}
+
+	commits := c.freeze(key)
+	if secret != nil {
+		if err := c.publish(ctx, key, common.RandomnessReveal, secret, commits); err != nil {
+			return nil, errors.Wrap(err, "error publishing the reveal")
+		}
+	}
+
+	timer := time.NewTimer(c.window)
+	defer timer.Stop()
+
+	for !c.allRevealed(key, commits) {
+		select {
+		case <-round.changed:
+		case <-timer.C:
+			return c.collect(key)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return c.collect(key)
+}
+
+// Handle saves the commitments and the reveals of the other nodes, including the ones that come before the local round starts
+func (c *CommitReveal) Handle(peerID peer.ID, msg common.RandomnessMessage) {
+	key := randomnessKey{RequestID: msg.RequestID, Attempt: msg.Attempt}
+	round := c.round(key)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch msg.Phase {
+	case common.RandomnessCommit:
+		if round.frozen {
+			slog.Info("ignoring a late commitment", slog.String("requestID", msg.RequestID), slog.String("peer", peerID.String()))
+
+			return
+		}
+
+		if _, ok := round.commits[peerID]; !ok {
+			round.commits[peerID] = msg.Value
+		}
+	case common.RandomnessReveal:
+		if _, ok := round.reveals[peerID]; !ok {
+			round.reveals[peerID] = msg
+		}
+	}
+
+	select {
+	case round.changed <- struct{}{}:
+	default:
+	}
+}
+
+// round returns the state of the round, the abandoned rounds are forgotten here as well
+func (c *CommitReveal) round(key randomnessKey) *commitRevealRound {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, r := range c.rounds {
+		if time.Since(r.createdAt) > c.window*10 {
+			delete(c.rounds, k)
+		}
+	}
+
+	round, ok := c.rounds[key]
+	if !ok {
+		round = &commitRevealRound{
+			commits:   make(map[peer.ID][]byte),
+			reveals:   make(map[peer.ID]common.RandomnessMessage),
+			changed:   make(chan struct{}, 1),
+			createdAt: time.Now(),
+		}
+		c.rounds[key] = round
+	}
+
+	return round
+}
+
+func (c *CommitReveal) freeze(key randomnessKey) map[peer.ID][]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	round := c.rounds[key]
+	round.frozen = true
+
+	return maps.Clone(round.commits)
+}
+
+func (c *CommitReveal) allRevealed(key randomnessKey, commits map[peer.ID][]byte) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	round := c.rounds[key]
+	for peerID := range commits {
+		if _, ok := round.reveals[peerID]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// collect takes the committers listed by the majority of the reveals, so the nodes that closed their commit windows
+// at different times agree on them. The committers that didn't reveal are taken with their commitments
+func (c *CommitReveal) collect(key randomnessKey) ([]common.RandomnessContribution, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	round := c.rounds[key]
+	delete(c.rounds, key)
+
+	type listedCommit struct {
+		peerID     peer.ID
+		commitment string
+	}
+
+	listings := make(map[listedCommit]int)
+	for _, reveal := range round.reveals {
+		for peerID, committed := range reveal.Commitments {
+			listings[listedCommit{peerID: peerID, commitment: string(committed)}]++
+		}
+	}
+
+	res := make([]common.RandomnessContribution, 0, len(listings))
+	for listed, count := range listings {
+		if count*2 <= len(round.reveals) {
+			continue
+		}
+
+		reveal, ok := round.reveals[listed.peerID]
+		if !ok || !bytes.Equal([]byte(listed.commitment), commitment(listed.peerID, reveal.Value)) {
+			slog.Warn("committer didn't reveal its secret",
+				slog.String("requestID", key.RequestID),
+				slog.String("peer", listed.peerID.String()),
+			)
+
+			res = append(res, common.RandomnessContribution{
+				Source:   listed.peerID.String(),
+				Value:    []byte(listed.commitment),
+				Withheld: true,
+			})
+
+			continue
+		}
+
+		res = append(res, common.RandomnessContribution{
+			Source: listed.peerID.String(),
+			Value:  reveal.Value,
+		})
+	}
+
+	if !slices.ContainsFunc(res, func(c common.RandomnessContribution) bool { return !c.Withheld }) {
+		return nil, errNoReveals
+	}
+
+	return res, nil
+}
+
+func (c *CommitReveal) publish(ctx context.Context, key randomnessKey, phase common.RandomnessPhase, value []byte, commits map[peer.ID][]byte) error {
+	return c.pubsub.Publish(ctx, common.RandomnessTopic, common.RandomnessMessage{
+		RequestID:   key.RequestID,
+		Attempt:     key.Attempt,
+		Phase:       phase,
+		Value:       value,
+		Commitments: commits,
+	})
+}
+
+// commitment binds the secret to its author, so the others can't replay it as their own
+func commitment(peerID peer.ID, secret []byte) []byte {
+	hash := sha256.Sum256(append(slices.Clone(secret), []byte(peerID)...))
+
+	return hash[:]
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
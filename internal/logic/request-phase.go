@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/pkg/errors"
+	"slices"
+)
+
+var errInvalidPhaseTransition = errors.New("invalid request phase transition")
+
+// phaseTransitions is the request state machine, it lists the phases every phase is allowed to move to
+var phaseTransitions = map[common.RequestPhase][]common.RequestPhase{
+	common.PhaseUnknown:         {common.PhaseSelectingProver},
+	common.PhaseSelectingProver: {common.PhaseProving, common.PhaseFailed},
+	common.PhaseProving:         {common.PhaseValidating, common.PhaseSelectingProver, common.PhaseFailed},
+	common.PhaseValidating:      {common.PhaseFinalized, common.PhaseSelectingProver, common.PhaseFailed},
+	common.PhaseFinalized:       {common.PhaseSubmitted},
+	common.PhaseSubmitted:       {},
+	common.PhaseFailed:          {},
+}
+
+// checkPhaseTransition allows staying in the same phase, because the same event can be observed more than once
+func checkPhaseTransition(from, to common.RequestPhase) error {
+	if from == to || slices.Contains(phaseTransitions[from], to) {
+		return nil
+	}
+
+	return errors.Wrapf(errInvalidPhaseTransition, "%s -> %s", from.String(), to.String())
+}
+
+func toRequestStatus(req common.RequestExtension) common.RequestStatus {
+	attempts := make([]common.ProvingAttempt, 0, len(req.ProvingPeers))
+	for _, peerID := range req.ProvingPeers {
+		attempt := common.ProvingAttempt{
+			PeerID:   peerID,
+			ProofID:  req.Proofs[peerID].ProofID,
+			TimedOut: slices.Contains(req.TimedOutPeers, peerID),
+		}
+
+		for _, isValid := range req.ValidationVotes[peerID] {
+			if isValid {
+				attempt.ValidVotes++
+			} else {
+				attempt.InvalidVotes++
+			}
+		}
+
+		attempts = append(attempts, attempt)
+	}
+
+	return common.RequestStatus{
+		RequestID:        req.ID,
+		Phase:            req.Phase,
+		Attempts:         attempts,
+		SubmissionTxHash: req.SubmissionTxHash,
+	}
+}
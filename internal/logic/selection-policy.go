@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"time"
+)
+
+const (
+	// memoryPerCoreMB is the memory a core needs for proving, the cores without it are not counted
+	memoryPerCoreMB = 2048
+	millisPerHour   = int64(time.Hour / time.Millisecond)
+)
+
+// SelectionPolicy weighs the candidates of the prover selection, a candidate with zero weight is never selected.
+// The weights depend on the gossiped node data, which the nodes can see at different moments, so the nodes
+// can select different candidates and the selection vote settles on one of them
+type SelectionPolicy interface {
+	Name() string
+	Weight(node common.NodeData, consumerImage string) uint64
+}
+
+func NewSelectionPolicy(cfg *common.Config) SelectionPolicy {
+	if cfg.SelectionPolicy == common.SelectionPolicyUniform {
+		return UniformPolicy{}
+	}
+
+	return CapacityPolicy{}
+}
+
+// UniformPolicy gives every candidate the same chance
+type UniformPolicy struct{}
+
+func (UniformPolicy) Name() string {
+	return common.SelectionPolicyUniform
+}
+
+func (UniformPolicy) Weight(common.NodeData, string) uint64 {
+	return 1
+}
+
+// CapacityPolicy weighs the candidates by the throughput of their free slots: the proofs per hour measured
+// for the consumer, or the number of the cores backed by enough memory for the nodes that haven't proved it yet
+type CapacityPolicy struct{}
+
+func (CapacityPolicy) Name() string {
+	return common.SelectionPolicyCapacity
+}
+
+func (CapacityPolicy) Weight(node common.NodeData, consumerImage string) uint64 {
+	capacity := node.Capacity
+	var speed int64
+	if ms, ok := capacity.ProvingTimes[consumerImage]; ok && ms > 0 {
+		speed = millisPerHour / ms
+	} else {
+		speed = int64(capacity.Cores)
+		if capacity.MemoryMB > 0 {
+			speed = min(speed, int64(capacity.MemoryMB/memoryPerCoreMB))
+		}
+	}
+
+	free := capacity.FreeSlotsFor(consumerImage)
+	if free == 0 {
+		// a busy node only queues the proof, it's chosen when all the candidates are busy
+		return uint64(min(capacity.QueueFree(), 1))
+	}
+
+	return uint64(free) * uint64(max(speed, 1))
+}
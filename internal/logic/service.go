@@ -8,10 +8,14 @@ import (
 	"fmt"
 	"github.com/dimazhornyk/generic-proving-network/internal/common"
 	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
+	"github.com/dimazhornyk/generic-proving-network/internal/metrics"
+	"github.com/dimazhornyk/generic-proving-network/internal/tracing"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
 	"io"
 	"log/slog"
 	"net/http"
@@ -22,20 +26,33 @@ import (
 const proveURL = "http://localhost:%s/prove"
 const validateURL = "http://localhost:%s/validate"
 
-var ErrNoProof = errors.New("no proof found")
+var (
+	ErrNoProof                  = errors.New("no proof found")
+	ErrRequestFailed            = errors.New("request has failed")
+	ErrInvalidConsumerSignature = errors.New("invalid consumer signature")
+	ErrUnknownConsumer          = errors.New("consumer is not registered")
+	ErrConsumerImageMismatch    = errors.New("consumer image is not registered by the consumer")
+)
 
 type Service struct {
 	docker              *connectors.Docker
 	pubsub              *connectors.PubSub
 	host                host.Host
-	nodes               StatusMap
+	nodes               *StatusMap
 	storage             *Storage
 	status              *StatusSharing
 	consumers           []common.Consumer
 	networkParticipants *NetworkParticipants
+	lookup              *ProofLookup
+	randomness          RandomnessSource
+	policy              SelectionPolicy
+	capacity            *CapacityMeter
+	jobs                *JobQueue
+	ledger              *ConsumerLedger
+	testingMode         bool
 }
 
-func NewService(cfg *common.Config, d *connectors.Docker, pubsub *connectors.PubSub, nodes StatusMap, storage *Storage, status *StatusSharing, host host.Host, eth *connectors.Ethereum, np *NetworkParticipants) (*Service, error) {
+func NewService(cfg *common.Config, d *connectors.Docker, pubsub *connectors.PubSub, nodes *StatusMap, storage *Storage, status *StatusSharing, host host.Host, eth *connectors.Ethereum, np *NetworkParticipants, lookup *ProofLookup, randomness RandomnessSource, policy SelectionPolicy, capacity *CapacityMeter, jobs *JobQueue, ledger *ConsumerLedger) (*Service, error) {
 	var consumers []common.Consumer
 
 	if cfg.Mode == common.TestingMode {
@@ -62,6 +79,13 @@ func NewService(cfg *common.Config, d *connectors.Docker, pubsub *connectors.Pub
 		host:                host,
 		consumers:           consumers,
 		networkParticipants: np,
+		lookup:              lookup,
+		randomness:          randomness,
+		policy:              policy,
+		capacity:            capacity,
+		jobs:                jobs,
+		ledger:              ledger,
+		testingMode:         cfg.Mode == common.TestingMode,
 	}, nil
 }
 
@@ -77,17 +101,31 @@ func (s *Service) Start() error {
 	return nil
 }
 
-func (s *Service) InitiateProofCalculation(ctx context.Context, req common.ComputeProofRequest) error {
+func (s *Service) InitiateProofCalculation(ctx context.Context, req common.ComputeProofRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "Service.InitiateProofCalculation", trace.WithAttributes(tracing.RequestID(req.ID)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	msg := common.ProvingRequestMessage{
 		ID:              req.ID,
 		ConsumerImage:   req.ConsumerImage,
 		ConsumerAddress: req.ConsumerAddress,
 		Signature:       req.Signature,
 		Data:            req.Data,
+		Reward:          req.Reward,
 		Timestamp:       time.Now().UnixNano(),
 	}
 
-	// todo: check that consumer is in a list in contract, verify signature
+	if err := s.VerifyProvingRequest(msg); err != nil {
+		return errors.Wrap(err, "error verifying the proving request")
+	}
+
+	// the other nodes check it again when they accept the request
+	if err := s.ledger.Check(msg); err != nil {
+		return errors.Wrap(err, "error checking the consumer balance")
+	}
+
 	slog.Info("new request", slog.String("requestID", req.ID), slog.String("consumerImage", req.ConsumerImage))
 	if err := s.pubsub.Publish(ctx, common.RequestsTopic, msg); err != nil {
 		return errors.Wrap(err, "error publishing the proving request")
@@ -96,19 +134,103 @@ func (s *Service) InitiateProofCalculation(ctx context.Context, req common.Compu
 	return nil
 }
 
-func (s *Service) GetProof(requestID common.RequestID) (common.ZKProof, error) {
-	proof, err := s.storage.GetFromResultsStorage(requestID)
+// VerifyProvingRequest checks that the request is signed by the registered consumer, who owns the requested image
+func (s *Service) VerifyProvingRequest(msg common.ProvingRequestMessage) error {
+	hash := common.ProvingRequestHash(msg.ID, msg.Reward)
+	addr, err := common.RecoverAddress(hash, msg.Signature)
 	if err != nil {
-		slog.Warn("no proof in storage", slog.String("requestID", requestID))
+		return errors.Wrap(ErrInvalidConsumerSignature, err.Error())
+	}
+
+	if !ethcommon.IsHexAddress(msg.ConsumerAddress) || ethcommon.HexToAddress(msg.ConsumerAddress) != addr {
+		return errors.Wrapf(ErrInvalidConsumerSignature, "signer %s is not the consumer %s", addr.Hex(), msg.ConsumerAddress)
+	}
+
+	if s.testingMode {
+		return nil
+	}
+
+	if !s.networkParticipants.IsKnownConsumer(addr) {
+		return errors.Wrapf(ErrUnknownConsumer, "address: %s", addr.Hex())
+	}
+
+	consumer, ok := s.networkParticipants.GetConsumer(addr)
+	if !ok {
+		return errors.Wrapf(ErrUnknownConsumer, "address: %s", addr.Hex())
+	}
+
+	if consumer.Image != msg.ConsumerImage {
+		return errors.Wrapf(ErrConsumerImageMismatch, "requested: %s, registered: %s", msg.ConsumerImage, consumer.Image)
+	}
+
+	return nil
+}
+
+// GetProof falls back to asking the other nodes when the proof isn't in the local results storage,
+// consumerImage narrows the lookup down to the nodes committed to the consumer and can be empty
+func (s *Service) GetProof(ctx context.Context, requestID common.RequestID, consumerImage string) (common.ZKProof, error) {
+	result, err := s.storage.GetFromResultsStorage(requestID)
+	if err == nil {
+		return result.Proof, nil
+	}
+
+	if record, err := s.storage.GetFailureRecord(requestID); err == nil {
+		return common.ZKProof{}, errors.Wrapf(ErrRequestFailed, "after %d attempts", len(record.Attempts))
+	}
+
+	if consumerImage == "" {
+		if req, err := s.storage.GetProvingRequestByID(requestID); err == nil {
+			consumerImage = req.ConsumerImage
+		}
+	}
+
+	result, err = s.lookup.FetchProof(ctx, requestID, consumerImage)
+	if err != nil {
+		slog.Warn("no proof in storage and in the network", slog.String("requestID", requestID))
 
 		return common.ZKProof{}, ErrNoProof
 	}
 
-	return proof, nil
+	if err := s.storage.SaveToResultsStorage(result); err != nil {
+		slog.Error("error caching fetched proof", slog.String("requestID", requestID), slog.String("err", err.Error()))
+	}
+
+	return result.Proof, nil
+}
+
+// GetRequestStatus returns PhaseUnknown for the requests this node has never seen
+func (s *Service) GetRequestStatus(requestID common.RequestID) (common.RequestStatus, error) {
+	status, err := s.storage.GetRequestStatus(requestID)
+	if err != nil {
+		if errors.Is(err, errUnknownRequest) {
+			return common.RequestStatus{
+				RequestID: requestID,
+				Phase:     common.PhaseUnknown,
+			}, nil
+		}
+
+		return common.RequestStatus{}, errors.Wrap(err, "error getting request status")
+	}
+
+	return status, nil
 }
 
-func (s *Service) HandleProverSelection(ctx context.Context, msg common.ProvingRequestMessage, excludedPeers ...peer.ID) error {
-	proverID, err := s.selectProvingNode(msg.ConsumerImage, msg.Timestamp, excludedPeers...)
+func (s *Service) HandleProverSelection(ctx context.Context, msg common.ProvingRequestMessage, excludedPeers ...peer.ID) (err error) {
+	ctx, span := tracing.Start(ctx, "Service.HandleProverSelection", trace.WithAttributes(tracing.RequestID(msg.ID)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	if err := s.storage.SetPhase(msg.ID, common.PhaseSelectingProver); err != nil {
+		return errors.Wrap(err, "error moving request to the prover selection")
+	}
+
+	// the job of the previous attempt is stale once the prover is re-selected
+	if err := s.jobs.Remove(msg.ID); err != nil {
+		slog.Error("error dropping the proving job", slog.String("requestID", msg.ID), slog.String("err", err.Error()))
+	}
+
+	proverID, err := s.selectProvingNode(ctx, msg, excludedPeers...)
 	if err != nil {
 		return errors.Wrap(err, "error selecting prover")
 	}
@@ -117,25 +239,108 @@ func (s *Service) HandleProverSelection(ctx context.Context, msg common.ProvingR
 		slog.String("nodeID", proverID.String()),
 		slog.String("requestID", msg.ID),
 	)
+	span.SetAttributes(tracing.PeerID(proverID))
 
-	if err := s.voteProverSelection(ctx, msg.ID, proverID); err != nil {
+	if err := s.voteProverSelection(ctx, msg.ID, proverID, len(excludedPeers)); err != nil {
 		return errors.Wrap(err, "error voting for prover selection")
 	}
 
-	if proverID == s.host.ID() {
-		slog.Info("I am the selected node, starting proving...")
+	return nil
+}
+
+// EnqueueProving is called once the network selects this node, it declines the request when the queue is full,
+// so the others select another prover without waiting for the deadline
+func (s *Service) EnqueueProving(ctx context.Context, msg common.ProvingRequestMessage, attempt int) error {
+	err := s.jobs.Push(common.ProvingJob{
+		Request: msg,
+		Attempt: attempt,
+	})
+	if err == nil {
+		slog.Info("I am the selected node, queued proving", slog.String("requestID", msg.ID))
+		s.status.Refresh(ctx)
+
+		return nil
+	}
+
+	if !errors.Is(err, ErrQueueFull) {
+		return errors.Wrap(err, "error queueing the proving job")
+	}
 
-		proof, err := s.computeProof(ctx, msg)
-		if err != nil {
-			return errors.Wrap(err, "error computing the proof")
+	slog.Warn("proving queue is full, declining the request", slog.String("requestID", msg.ID))
+	declined := common.VotingMessage{
+		Type: common.VoteProverDeclined,
+		Payload: common.ProverDeclinedPayload{
+			RequestID: msg.ID,
+			Attempt:   attempt,
+		},
+	}
+
+	return errors.Wrap(s.pubsub.Publish(ctx, common.VotingTopic, declined), "error declining the request")
+}
+
+// RunJobs starts the queued jobs as the proving slots become free
+func (s *Service) RunJobs(ctx context.Context) {
+	for {
+		released := s.capacity.Released()
+		pushed := s.jobs.Changed()
+
+		job, ok := s.jobs.Next(func(job common.ProvingJob) bool {
+			return s.capacity.TryAcquire(job.Request.ConsumerImage)
+		})
+		if ok {
+			s.status.Refresh(ctx)
+			go s.runJob(ctx, job)
+
+			continue
 		}
 
-		if err := s.submitProof(msg.ID, proof); err != nil {
-			return errors.Wrap(err, "error submitting the proof")
+		select {
+		case <-released:
+		case <-pushed:
+		case <-ctx.Done():
+			return
 		}
 	}
+}
 
-	return nil
+func (s *Service) runJob(ctx context.Context, job common.ProvingJob) {
+	if !s.isJobCurrent(job) {
+		slog.Info("dropping the stale proving job", slog.String("requestID", job.Request.ID), slog.Int("attempt", job.Attempt))
+		s.capacity.Release(job.Request.ConsumerImage, 0, true)
+		s.status.Refresh(ctx)
+
+		if err := s.jobs.Done(job.Request.ID); err != nil {
+			slog.Error("error finishing the proving job", slog.String("err", err.Error()))
+		}
+
+		return
+	}
+
+	slog.Info("starting proving", slog.String("requestID", job.Request.ID))
+
+	proof, err := s.computeProof(ctx, job.Request)
+	if err != nil {
+		slog.Error("error computing the proof", slog.String("requestID", job.Request.ID), slog.String("err", err.Error()))
+	} else if !s.isJobCurrent(job) {
+		slog.Info("dropping the proof of the stale job", slog.String("requestID", job.Request.ID), slog.Int("attempt", job.Attempt))
+	} else if err := s.submitProof(job.Request.ID, proof); err != nil {
+		slog.Error("error submitting the proof", slog.String("requestID", job.Request.ID), slog.String("err", err.Error()))
+	}
+
+	// the failed jobs aren't retried, the deadline watcher re-selects the prover
+	if err := s.jobs.Done(job.Request.ID); err != nil {
+		slog.Error("error finishing the proving job", slog.String("err", err.Error()))
+	}
+}
+
+// isJobCurrent reports whether this node is still the prover of the job's attempt
+func (s *Service) isJobCurrent(job common.ProvingJob) bool {
+	req, err := s.storage.GetProvingRequestByID(job.Request.ID)
+	if err != nil || req.Phase != common.PhaseProving {
+		return false
+	}
+
+	return len(req.ProvingPeers) == job.Attempt+1 && IsCurrentProver(req, s.host.ID())
 }
 
 func (s *Service) submitProof(requestID common.RequestID, proof []byte) error {
@@ -145,6 +350,11 @@ func (s *Service) submitProof(requestID common.RequestID, proof []byte) error {
 		Proof:     proof,
 	}
 
+	// the proofs handler skips our own proofs, so the proof is saved here
+	if err := s.storage.AddProof(requestID, s.host.ID(), msg.ProofID, proof); err != nil {
+		return errors.Wrap(err, "error saving the proof")
+	}
+
 	if err := s.pubsub.Publish(context.Background(), common.ProofsTopic, msg); err != nil {
 		return errors.Wrap(err, "error publishing the proof")
 	}
@@ -152,12 +362,13 @@ func (s *Service) submitProof(requestID common.RequestID, proof []byte) error {
 	return nil
 }
 
-func (s *Service) voteProverSelection(ctx context.Context, requestID common.RequestID, provingNodeID peer.ID) error {
+func (s *Service) voteProverSelection(ctx context.Context, requestID common.RequestID, provingNodeID peer.ID, attempt int) error {
 	msg := common.VotingMessage{
 		Type: common.VoteProverSelection,
 		Payload: common.ProverSelectionPayload{
 			RequestID: requestID,
 			PeerID:    provingNodeID,
+			Attempt:   attempt,
 		},
 	}
 
@@ -168,39 +379,87 @@ func (s *Service) voteProverSelection(ctx context.Context, requestID common.Requ
 	return nil
 }
 
-func (s *Service) selectProvingNode(consumerImage string, requestTimestamp int64, excludeList ...peer.ID) (peer.ID, error) {
+// selectProvingNode records the inputs of the selection, so the other nodes can audit it
+func (s *Service) selectProvingNode(ctx context.Context, msg common.ProvingRequestMessage, excludeList ...peer.ID) (peer.ID, error) {
+	attempt := len(excludeList)
+	contributions, err := s.randomness.Contributions(ctx, msg, attempt)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting %s randomness", s.randomness.Name())
+	}
+
+	// the contributors that withheld their secrets aren't selected, so withholding doesn't pay off
+	excluded := slices.Clone(excludeList)
+	for _, c := range contributions {
+		if peerID, err := peer.Decode(c.Source); err == nil && c.Withheld {
+			excluded = append(excluded, peerID)
+		}
+	}
+
 	nodes := make([]common.NodeData, 0)
-	for _, node := range s.nodes {
-		// is committed to the consumer, is idle, went up earlier than request was sent, is not in the exclude list
-		if slices.Contains(node.Commitments, consumerImage) && isNodeAppropriate(node, requestTimestamp) && !slices.Contains(excludeList, node.PeerID) {
+	for _, node := range s.nodes.ReachableNodes() {
+		// is committed to the consumer, has a free slot or room in the queue, went up earlier than request was sent, is not excluded
+		if slices.Contains(node.Commitments, msg.ConsumerImage) && isNodeAppropriate(node, msg.ConsumerImage, msg.Timestamp) && !slices.Contains(excluded, node.PeerID) {
 			nodes = append(nodes, node)
 		}
 	}
-	slices.SortStableFunc(nodes, func(a, b common.NodeData) int {
+	if len(nodes) == 0 {
+		return "", errors.Errorf("no reachable nodes with free slots or queue room committed to %s", msg.ConsumerImage)
+	}
+
+	slices.SortFunc(nodes, func(a, b common.NodeData) int {
 		return cmp.Compare(a.PeerID.String(), b.PeerID.String())
 	})
 
-	var seed []byte
-	latestProof := s.storage.GetLatestProof(consumerImage)
-	if latestProof == nil {
-		seed = []byte(consumerImage)
-	} else {
-		seed = latestProof.Proof
+	candidates := make([]peer.ID, len(nodes))
+	weights := make([]uint64, len(nodes))
+	for i, node := range nodes {
+		candidates[i] = node.PeerID
+		weights[i] = s.policy.Weight(node, msg.ConsumerImage)
 	}
 
-	random, err := common.BytesToRandom(seed)
+	seed := common.SelectionSeed(contributions, msg.ID, attempt)
+	selected, err := common.SelectCandidate(seed, candidates, weights)
 	if err != nil {
-		return "", errors.Wrap(err, "error getting random from last proof")
+		return "", errors.Wrap(err, "error selecting a candidate")
+	}
+
+	record := common.SelectionRecord{
+		RequestID:     msg.ID,
+		Attempt:       attempt,
+		Source:        s.randomness.Name(),
+		Contributions: contributions,
+		Seed:          seed,
+		Candidates:    candidates,
+		Policy:        s.policy.Name(),
+		Weights:       weights,
+		Selected:      selected,
+		CreatedAt:     time.Now().UnixNano(),
+	}
+	if err := s.storage.SaveSelectionRecord(record); err != nil {
+		return "", errors.Wrap(err, "error saving the selection record")
 	}
 
-	idx := random.Intn(len(nodes))
+	return selected, nil
+}
 
-	return nodes[idx].PeerID, nil
+// GetSelectionRecords returns the recorded selections of the request
+func (s *Service) GetSelectionRecords(requestID common.RequestID) ([]common.SelectionRecord, error) {
+	return s.storage.ListSelectionRecords(requestID)
 }
 
-func (s *Service) computeProof(ctx context.Context, req common.ProvingRequestMessage) ([]byte, error) {
-	s.status.SetStatus(ctx, common.StatusProving)
-	defer s.status.SetStatus(ctx, common.StatusIdle)
+func (s *Service) computeProof(ctx context.Context, req common.ProvingRequestMessage) (proof []byte, err error) {
+	ctx, span := tracing.Start(ctx, "Service.computeProof", trace.WithAttributes(tracing.RequestID(req.ID)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	// the slot is taken by RunJobs
+	start := time.Now()
+	defer func() {
+		s.capacity.Release(req.ConsumerImage, time.Since(start), err != nil)
+		s.status.Refresh(ctx)
+		observeProverCall(req.ConsumerImage, metrics.OperationProve, start, err)
+	}()
 
 	if req.ConsumerImage == "" {
 		return nil, errors.New("unknown consumer")
@@ -238,7 +497,12 @@ func (s *Service) computeProof(ctx context.Context, req common.ProvingRequestMes
 	return response.Proof, nil
 }
 
-func (s *Service) ValidateProof(requestID common.RequestID, consumerImage string, data, proof []byte) (bool, error) {
+func (s *Service) ValidateProof(requestID common.RequestID, consumerImage string, data, proof []byte) (valid bool, err error) {
+	start := time.Now()
+	defer func() {
+		observeProverCall(consumerImage, metrics.OperationValidate, start, err)
+	}()
+
 	if consumerImage == "" {
 		return false, errors.New("unknown consumer")
 	}
@@ -276,6 +540,17 @@ func (s *Service) ValidateProof(requestID common.RequestID, consumerImage string
 	return response.Valid, nil
 }
 
-func isNodeAppropriate(node common.NodeData, maxTimestamp int64) bool {
-	return node.Status == common.StatusIdle && node.AvailableSince < maxTimestamp
+func observeProverCall(consumerImage, operation string, start time.Time, err error) {
+	metrics.ProverCallDuration.WithLabelValues(consumerImage, operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		metrics.ProverCallErrors.WithLabelValues(consumerImage, operation).Inc()
+	}
+}
+
+// isNodeAppropriate accepts the proving nodes as well, as long as they have a free slot for the consumer or room in the queue
+func isNodeAppropriate(node common.NodeData, consumerImage string, maxTimestamp int64) bool {
+	active := node.Status == common.StatusIdle || node.Status == common.StatusProving
+	hasRoom := node.Capacity.FreeSlotsFor(consumerImage) > 0 || node.Capacity.QueueFree() > 0
+
+	return node.Reachable && active && hasRoom && node.AvailableSince < maxTimestamp
 }
@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// unreachableLabel is used instead of the status for the nodes that missed too many heartbeats
+const unreachableLabel = "unreachable"
+
+var nodesDesc = prometheus.NewDesc(
+	"gpn_network_nodes",
+	"Number of the known nodes, per status, the unreachable ones are counted separately",
+	[]string{"status"},
+	nil,
+)
+
+var connectionsDesc = prometheus.NewDesc(
+	"gpn_network_connections",
+	"Number of the open peer connections",
+	nil,
+	nil,
+)
+
+// StateCollector exports the node's view of the network on every scrape
+type StateCollector struct {
+	nodes       *StatusMap
+	connections *ConnectionHolder
+}
+
+func NewStateCollector(nodes *StatusMap, connections *ConnectionHolder) *StateCollector {
+	return &StateCollector{
+		nodes:       nodes,
+		connections: connections,
+	}
+}
+
+func (c *StateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- nodesDesc
+	ch <- connectionsDesc
+}
+
+func (c *StateCollector) Collect(ch chan<- prometheus.Metric) {
+	counts := make(map[common.Status]int)
+	unreachable := 0
+	for _, node := range c.nodes.Nodes() {
+		if !node.Reachable {
+			unreachable++
+
+			continue
+		}
+
+		counts[node.Status]++
+	}
+
+	for _, status := range []common.Status{common.StatusInit, common.StatusIdle, common.StatusProving, common.StatusShuttingDown} {
+		ch <- prometheus.MustNewConstMetric(nodesDesc, prometheus.GaugeValue, float64(counts[status]), status.String())
+	}
+	ch <- prometheus.MustNewConstMetric(nodesDesc, prometheus.GaugeValue, float64(unreachable), unreachableLabel)
+
+	ch <- prometheus.MustNewConstMetric(connectionsDesc, prometheus.GaugeValue, float64(c.connections.Len()))
+}
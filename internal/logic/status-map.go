@@ -1,35 +1,157 @@
 package logic
 
 import (
+	"context"
 	"github.com/dimazhornyk/generic-proving-network/internal/common"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	"log/slog"
+	"sync"
 	"time"
 )
 
-type StatusMap map[peer.ID]common.NodeData
+var ErrUnknownPeer = errors.New("unknown peerID")
 
-func NewStatusMap() StatusMap {
-	return make(StatusMap)
+// StatusMap is the registry of the known nodes, every status message is a heartbeat
+type StatusMap struct {
+	m  map[peer.ID]common.NodeData
+	mu sync.RWMutex
+
+	heartbeatInterval time.Duration
+	unreachableAfter  time.Duration
+	evictAfter        time.Duration
+}
+
+func NewStatusMap(cfg *common.Config) *StatusMap {
+	m := &StatusMap{
+		m:                 make(map[peer.ID]common.NodeData),
+		heartbeatInterval: cfg.HeartbeatInterval,
+		unreachableAfter:  cfg.HeartbeatInterval * time.Duration(cfg.MissedHeartbeats),
+		evictAfter:        cfg.HeartbeatInterval * time.Duration(cfg.EvictAfterHeartbeats),
+	}
+
+	return m
 }
 
-func (m StatusMap) Add(peerID peer.ID, status common.Status, commitments []string) {
-	m[peerID] = common.NodeData{
+func (m *StatusMap) Add(peerID peer.ID, status common.Status, commitments []string, capacity common.Capacity) {
+	now := time.Now().UnixNano()
+
+	m.mu.Lock()
+	m.m[peerID] = common.NodeData{
 		PeerID:         peerID,
 		Status:         status,
 		Commitments:    commitments,
-		AvailableSince: time.Now().UnixNano(),
+		AvailableSince: now,
+		LastHeartbeat:  now,
+		Reachable:      true,
+		Capacity:       capacity,
 	}
+	m.mu.Unlock()
 }
 
-func (m StatusMap) UpdateStatus(peerID peer.ID, status common.Status) error {
-	node, ok := m[peerID]
+// UpdateStatus is a heartbeat, an evicted peer is admitted again with the commitments the heartbeat carries,
+// the listener drops the messages of the peers that aren't registered
+func (m *StatusMap) UpdateStatus(peerID peer.ID, status common.Status, commitments []string, capacity common.Capacity) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	node, ok := m.m[peerID]
 	if !ok {
-		return errors.New("unknown peerID")
+		if len(commitments) == 0 {
+			return ErrUnknownPeer
+		}
+
+		slog.Info("peer is admitted again", slog.String("peerID", peerID.String()))
+		node = common.NodeData{
+			PeerID:         peerID,
+			AvailableSince: time.Now().UnixNano(),
+		}
+	}
+
+	if len(commitments) > 0 {
+		node.Commitments = commitments
+	}
+
+	if ok && !node.Reachable {
+		slog.Info("peer is reachable again", slog.String("peerID", peerID.String()))
 	}
 
 	node.Status = status
-	m[peerID] = node
+	node.Capacity = capacity
+	node.LastHeartbeat = time.Now().UnixNano()
+	node.Reachable = true
+	m.m[peerID] = node
 
 	return nil
 }
+
+func (m *StatusMap) Get(peerID peer.ID) (common.NodeData, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	node, ok := m.m[peerID]
+
+	return node, ok
+}
+
+// Nodes returns a snapshot of all the known nodes, including the unreachable ones
+func (m *StatusMap) Nodes() []common.NodeData {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	nodes := make([]common.NodeData, 0, len(m.m))
+	for _, node := range m.m {
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+// ReachableNodes returns a snapshot of the nodes that didn't miss too many heartbeats
+func (m *StatusMap) ReachableNodes() []common.NodeData {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	nodes := make([]common.NodeData, 0, len(m.m))
+	for _, node := range m.m {
+		if node.Reachable {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+// Run marks the silent peers unreachable and evicts them after a while, until ctx is done
+func (m *StatusMap) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		now := time.Now()
+
+		m.mu.Lock()
+		for peerID, node := range m.m {
+			silence := now.Sub(time.Unix(0, node.LastHeartbeat))
+			if silence > m.evictAfter {
+				delete(m.m, peerID)
+				slog.Info("evicted a peer", slog.String("peerID", peerID.String()))
+
+				continue
+			}
+
+			if node.Reachable && silence > m.unreachableAfter {
+				node.Reachable = false
+				m.m[peerID] = node
+				slog.Info("peer is unreachable", slog.String("peerID", peerID.String()))
+			}
+		}
+		m.mu.Unlock()
+	}
+}
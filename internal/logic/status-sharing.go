@@ -10,22 +10,32 @@ import (
 )
 
 type StatusSharing struct {
-	pubsub *connectors.PubSub
-	status common.Status
-	mu     sync.Mutex
+	pubsub      *connectors.PubSub
+	capacity    *CapacityMeter
+	status      common.Status
+	commitments []string // sent with every status, so the nodes that evicted this one admit it again
+	interval    time.Duration
+	mu          sync.Mutex
 }
 
-func NewGlobalMessaging(pubsub *connectors.PubSub) (*StatusSharing, error) {
+func NewGlobalMessaging(cfg *common.Config, pubsub *connectors.PubSub, capacity *CapacityMeter) (*StatusSharing, error) {
 	return &StatusSharing{
-		pubsub: pubsub,
-		status: common.StatusIdle,
+		pubsub:   pubsub,
+		capacity: capacity,
+		status:   common.StatusIdle,
+		interval: cfg.HeartbeatInterval,
 	}, nil
 }
 
 func (s *StatusSharing) Init(ctx context.Context, consumers []string) error {
+	s.mu.Lock()
+	s.commitments = consumers
+	s.mu.Unlock()
+
 	payload := common.StatusMessage{
-		Status:  common.StatusInit,
-		Payload: consumers,
+		Status:   common.StatusInit,
+		Payload:  consumers,
+		Capacity: s.capacity.Snapshot(),
 	}
 
 	if err := s.pubsub.SendStatusMessage(ctx, payload); err != nil {
@@ -37,6 +47,7 @@ func (s *StatusSharing) Init(ctx context.Context, consumers []string) error {
 	return nil
 }
 
+// SetStatus sets the lifecycle status of the node, an idle node is advertised as proving while it has active jobs
 func (s *StatusSharing) SetStatus(ctx context.Context, status common.Status) {
 	s.mu.Lock()
 	s.status = status
@@ -45,8 +56,13 @@ func (s *StatusSharing) SetStatus(ctx context.Context, status common.Status) {
 	s.shareStatus(ctx)
 }
 
+// Refresh shares the status right away, so the others learn about the taken and released slots before the next heartbeat
+func (s *StatusSharing) Refresh(ctx context.Context) {
+	s.shareStatus(ctx)
+}
+
 func (s *StatusSharing) worker(ctx context.Context) {
-	ticker := time.NewTicker(time.Second * 5)
+	ticker := time.NewTicker(s.interval) // the status messages are the heartbeats for the other nodes' StatusMap
 
 	for {
 		select {
@@ -62,13 +78,21 @@ func (s *StatusSharing) worker(ctx context.Context) {
 func (s *StatusSharing) shareStatus(ctx context.Context) {
 	s.mu.Lock()
 	status := s.status
+	commitments := s.commitments
 	s.mu.Unlock()
 
+	capacity := s.capacity.Snapshot()
+	if status == common.StatusIdle && capacity.ActiveJobs > 0 {
+		status = common.StatusProving
+	}
+
 	payload := common.StatusMessage{
-		Status: status,
+		Status:   status,
+		Payload:  commitments,
+		Capacity: capacity,
 	}
 
 	if err := s.pubsub.SendStatusMessage(ctx, payload); err != nil {
-		slog.Error("error on publishing status message", err)
+		slog.Error("error on publishing status message", slog.String("err", err.Error()))
 	}
 }
@@ -3,27 +3,44 @@ package logic
 import (
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+	"slices"
+	"strconv"
 	"sync"
 	"time"
 )
 
+const (
+	requestsPrefix      = "requests/"
+	latestProofsPrefix  = "latest-proofs/"
+	resultsPrefix       = "results/"
+	finishedPrefix      = "finished/"
+	failuresPrefix      = "failures/"
+	evidencePrefix      = "evidence/"
+	selectionsPrefix    = "selections/"
+	jobsPrefix          = "jobs/"
+	payoutsPrefix       = "payouts/"
+	reservationsPrefix  = "reservations/"
+	pendingProofsPrefix = "pending-proofs/"
+)
+
 var errUnknownRequest = errors.New("unknown request")
+var ErrNotCurrentProver = errors.New("peer is not the current prover of the request")
 
-// inmemory storage is a temporary solution, it should be replaced with a more persistent storage
+// Storage keeps requests and proofs in the storage backend, so they survive the node restarts
 type Storage struct {
-	resultsStorage  map[common.RequestID]common.ZKProof // TODO: implement properly, use disk
-	latestProofs    map[string]common.ZKProof
-	provingRequests map[common.RequestID]common.RequestExtension
-	mu              sync.RWMutex
+	backend connectors.StorageBackend
+	mu      sync.RWMutex
 }
 
-func NewStorage() *Storage {
+func NewStorage(backend connectors.StorageBackend) *Storage {
 	return &Storage{
-		latestProofs:    make(map[string]common.ZKProof),
-		provingRequests: make(map[string]common.RequestExtension),
+		backend: backend,
 	}
 }
 
@@ -31,159 +48,649 @@ func (s *Storage) GetLatestProof(consumerImage string) *common.ZKProof {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	if proof, ok := s.latestProofs[consumerImage]; ok {
-		return &proof
+	var proof common.ZKProof
+	if err := s.get(latestProofsPrefix+consumerImage, &proof); err != nil {
+		return nil
 	}
 
-	return nil
+	return &proof
 }
 
 func (s *Storage) GetProvingRequestByID(requestID common.RequestID) (common.RequestExtension, error) {
 	s.mu.RLock()
-	data, ok := s.provingRequests[requestID]
-	s.mu.RUnlock()
-
-	if !ok {
-		return common.RequestExtension{}, errUnknownRequest
-	}
+	defer s.mu.RUnlock()
 
-	return data, nil
+	return s.getRequest(requestID)
 }
 
 func (s *Storage) HasRequest(requestID common.RequestID) bool {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	_, ok := s.provingRequests[requestID]
+	ok, err := s.backend.Has([]byte(requestsPrefix + requestID))
 
-	return ok
+	return err == nil && ok
 }
 
 func (s *Storage) SaveRequest(data common.ProvingRequestMessage) error {
-	if s.HasRequest(data.ID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.getRequest(data.ID); err == nil {
 		return errors.New("request already exists")
 	}
 
-	s.mu.Lock()
-	s.provingRequests[data.ID] = common.RequestExtension{
+	return s.putRequest(common.RequestExtension{
 		ProvingRequestMessage: data,
+		Phase:                 common.PhaseSelectingProver,
+		SelectionStartedAt:    time.Now().UnixNano(),
 		ProvingPeers:          make([]peer.ID, 0),
 		Proofs:                make(map[peer.ID]common.ZKProof),
 		ValidationSignatures:  make(map[peer.ID]map[peer.ID][]byte),
+		ValidationVotes:       make(map[peer.ID]map[peer.ID]bool),
+	})
+}
+
+func (s *Storage) SetPhase(requestID common.RequestID, phase common.RequestPhase) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	req, err := s.getRequest(requestID)
+	if err != nil {
+		return err
 	}
-	s.mu.Unlock()
 
-	return nil
+	if err := checkPhaseTransition(req.Phase, phase); err != nil {
+		return err
+	}
+
+	if phase == common.PhaseSelectingProver && req.Phase != phase {
+		req.SelectionStartedAt = time.Now().UnixNano()
+	}
+	req.Phase = phase
+
+	return s.putRequest(req)
+}
+
+// MarkSubmitted records the submission of the finalized request, the request is already archived by then
+func (s *Storage) MarkSubmitted(requestID common.RequestID, txHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var req common.RequestExtension
+	if err := s.get(finishedPrefix+requestID, &req); err != nil {
+		if errors.Is(err, connectors.ErrKeyNotFound) {
+			return errUnknownRequest
+		}
+
+		return err
+	}
+
+	if err := checkPhaseTransition(req.Phase, common.PhaseSubmitted); err != nil {
+		return err
+	}
+
+	req.Phase = common.PhaseSubmitted
+	req.SubmissionTxHash = txHash
+
+	return errors.Wrap(s.put(finishedPrefix+requestID, req), "error saving the request")
 }
 
 func (s *Storage) AddProvingPeer(requestID common.RequestID, peerID peer.ID) error {
-	if !s.HasRequest(requestID) {
-		return errUnknownRequest
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	req, err := s.getRequest(requestID)
+	if err != nil {
+		return err
 	}
 
-	s.mu.RLock()
-	req := s.provingRequests[requestID]
-	s.mu.RUnlock()
+	if err := checkPhaseTransition(req.Phase, common.PhaseProving); err != nil {
+		return err
+	}
 
+	req.Phase = common.PhaseProving
 	req.ProvingPeers = append(req.ProvingPeers, peerID)
+	req.ProvingStartedAt = time.Now().UnixNano()
+
+	return s.putRequest(req)
+}
+
+// RecordProvingTimeout marks the prover as the one that missed the proving deadline
+func (s *Storage) RecordProvingTimeout(requestID common.RequestID, peerID peer.ID) error {
 	s.mu.Lock()
-	s.provingRequests[requestID] = req
-	s.mu.Unlock()
+	defer s.mu.Unlock()
 
-	return nil
+	req, err := s.getRequest(requestID)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(req.TimedOutPeers, peerID) {
+		return nil
+	}
+
+	req.TimedOutPeers = append(req.TimedOutPeers, peerID)
+
+	return s.putRequest(req)
 }
 
+// AddProof accepts the proof only from the current prover, the late proofs of the timed out provers are dropped
 func (s *Storage) AddProof(requestID common.RequestID, peerID peer.ID, proofID common.ProofID, proof []byte) error {
-	if !s.HasRequest(requestID) {
-		return errUnknownRequest
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	req, err := s.getRequest(requestID)
+	if err != nil {
+		return err
 	}
 
-	s.mu.RLock()
-	req := s.provingRequests[requestID]
-	s.mu.RUnlock()
+	if !IsCurrentProver(req, peerID) {
+		return errors.Wrap(ErrNotCurrentProver, peerID.String())
+	}
+
+	if err := checkPhaseTransition(req.Phase, common.PhaseValidating); err != nil {
+		return err
+	}
 
+	req.Phase = common.PhaseValidating
 	req.Proofs[peerID] = common.ZKProof{
 		ProofID:   proofID,
 		Proof:     proof,
 		Timestamp: time.Now().UnixNano(),
 	}
-	s.mu.Lock()
-	s.provingRequests[requestID] = req
-	s.mu.Unlock()
 
-	return nil
+	return s.putRequest(req)
 }
 
-func (s *Storage) DeleteProvingRequest(requestID common.RequestID) error {
-	if !s.HasRequest(requestID) {
-		return errUnknownRequest
+// IsCurrentProver reports whether the peer is the last selected prover of the request and didn't miss its deadline
+func IsCurrentProver(req common.RequestExtension, peerID peer.ID) bool {
+	if len(req.ProvingPeers) == 0 || req.ProvingPeers[len(req.ProvingPeers)-1] != peerID {
+		return false
+	}
+
+	return !slices.Contains(req.TimedOutPeers, peerID)
+}
+
+// FinalizeRequest moves the latest proof to the results and archives the request without its data,
+// so the request status stays available after the request is finished
+func (s *Storage) FinalizeRequest(requestID common.RequestID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	req, err := s.getRequest(requestID)
+	if err != nil {
+		return err
+	}
+
+	if err := checkPhaseTransition(req.Phase, common.PhaseFinalized); err != nil {
+		return err
 	}
 
-	s.mu.RLock()
-	req := s.provingRequests[requestID]
-	s.mu.RUnlock()
 	if len(req.ProvingPeers) == 0 {
 		return errors.New("no provers")
 	}
 
 	proverID := req.ProvingPeers[len(req.ProvingPeers)-1]
-	s.mu.RLock()
 	proof, ok := req.Proofs[proverID]
-	s.mu.RUnlock()
 	if !ok {
 		return errors.New("no proof for the latest prover")
 	}
 
+	result := common.FinalizedProof{
+		RequestID:            requestID,
+		ConsumerImage:        req.ConsumerImage,
+		ProverID:             proverID,
+		Proof:                proof,
+		ValidationSignatures: make(map[peer.ID][]byte),
+	}
+	for voterID, isValid := range req.ValidationVotes[proverID] {
+		if isValid {
+			result.ValidationSignatures[voterID] = req.ValidationSignatures[proverID][voterID]
+		}
+	}
+
+	if err := s.put(resultsPrefix+requestID, result); err != nil {
+		return errors.Wrap(err, "error saving the result")
+	}
+
+	if err := s.put(latestProofsPrefix+req.ConsumerImage, proof); err != nil {
+		return errors.Wrap(err, "error saving the latest proof")
+	}
+
+	req.Phase = common.PhaseFinalized
+
+	return s.archiveRequest(req)
+}
+
+// FailRequest marks the request failed and archives it together with the failure record,
+// which collects the negative validation signatures of every attempted prover
+func (s *Storage) FailRequest(requestID common.RequestID) (common.FailureRecord, error) {
 	s.mu.Lock()
-	s.resultsStorage[requestID] = proof
-	s.latestProofs[req.ConsumerImage] = proof
-	delete(s.provingRequests, requestID)
-	s.mu.Unlock()
+	defer s.mu.Unlock()
 
-	return nil
+	req, err := s.getRequest(requestID)
+	if err != nil {
+		return common.FailureRecord{}, err
+	}
+
+	if err := checkPhaseTransition(req.Phase, common.PhaseFailed); err != nil {
+		return common.FailureRecord{}, err
+	}
+
+	record := common.FailureRecord{
+		RequestID:     requestID,
+		ConsumerImage: req.ConsumerImage,
+		Attempts:      make([]common.FailedAttempt, 0, len(req.ProvingPeers)),
+		FailedAt:      time.Now().UnixNano(),
+	}
+	for _, proverID := range req.ProvingPeers {
+		attempt := common.FailedAttempt{
+			ProverID:           proverID,
+			ProofID:            req.Proofs[proverID].ProofID,
+			TimedOut:           slices.Contains(req.TimedOutPeers, proverID),
+			NegativeSignatures: make(map[peer.ID][]byte),
+		}
+		if proof, ok := req.Proofs[proverID]; ok {
+			attempt.ProofHash = common.ProofHash(proof.Proof)
+		}
+
+		for voterID, isValid := range req.ValidationVotes[proverID] {
+			if !isValid {
+				attempt.NegativeSignatures[voterID] = req.ValidationSignatures[proverID][voterID]
+			}
+		}
+
+		record.Attempts = append(record.Attempts, attempt)
+	}
+
+	if err := s.put(failuresPrefix+requestID, record); err != nil {
+		return common.FailureRecord{}, errors.Wrap(err, "error saving the failure record")
+	}
+
+	req.Phase = common.PhaseFailed
+
+	return record, s.archiveRequest(req)
 }
 
-func (s *Storage) GetFromResultsStorage(request common.RequestID) (common.ZKProof, error) {
+func (s *Storage) GetFailureRecord(requestID common.RequestID) (common.FailureRecord, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	proof, ok := s.resultsStorage[request]
-	if !ok {
-		return common.ZKProof{}, errors.New("no proof in the results storage")
+	var record common.FailureRecord
+	if err := s.get(failuresPrefix+requestID, &record); err != nil {
+		if errors.Is(err, connectors.ErrKeyNotFound) {
+			return common.FailureRecord{}, errUnknownRequest
+		}
+
+		return common.FailureRecord{}, err
+	}
+
+	return record, nil
+}
+
+func (s *Storage) SetFailureReportTx(requestID common.RequestID, txHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var record common.FailureRecord
+	if err := s.get(failuresPrefix+requestID, &record); err != nil {
+		return errors.Wrap(err, "error getting the failure record")
+	}
+
+	record.ReportTxHash = txHash
+
+	return errors.Wrap(s.put(failuresPrefix+requestID, record), "error saving the failure record")
+}
+
+// SaveEvidence returns false if the same evidence is already saved
+func (s *Storage) SaveEvidence(evidence common.Evidence) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ok, err := s.backend.Has([]byte(evidencePrefix + evidence.ID))
+	if err != nil {
+		return false, errors.Wrap(err, "error checking the evidence")
+	}
+
+	if ok {
+		return false, nil
+	}
+
+	return true, errors.Wrap(s.put(evidencePrefix+evidence.ID, evidence), "error saving the evidence")
+}
+
+func (s *Storage) GetEvidence(id string) (common.Evidence, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var evidence common.Evidence
+	if err := s.get(evidencePrefix+id, &evidence); err != nil {
+		return common.Evidence{}, errors.Wrap(err, "error getting the evidence")
+	}
+
+	return evidence, nil
+}
+
+// ListEvidence returns the evidence about the request or all of it if requestID is empty
+func (s *Storage) ListEvidence(requestID common.RequestID) ([]common.Evidence, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make([]common.Evidence, 0)
+	err := s.backend.Iterate([]byte(evidencePrefix), func(_, value []byte) error {
+		var evidence common.Evidence
+		if err := common.GobDecodeMessage(value, &evidence); err != nil {
+			return err
+		}
+
+		if requestID == "" || evidence.RequestID == requestID {
+			res = append(res, evidence)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing the evidence")
 	}
 
-	return proof, nil
+	return res, nil
 }
 
-func (s *Storage) AddValidationSignature(requestID common.RequestID, voterID, proverID peer.ID, signature []byte) error {
-	if !s.HasRequest(requestID) {
-		return errUnknownRequest
+func (s *Storage) SetEvidenceSubmitted(id string, txHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var evidence common.Evidence
+	if err := s.get(evidencePrefix+id, &evidence); err != nil {
+		return errors.Wrap(err, "error getting the evidence")
 	}
 
+	evidence.SubmissionTx = txHash
+
+	return errors.Wrap(s.put(evidencePrefix+id, evidence), "error saving the evidence")
+}
+
+// SaveSelectionRecord overwrites the record of the same attempt, the selection is repeated only after a restart
+func (s *Storage) SaveSelectionRecord(record common.SelectionRecord) error {
 	s.mu.Lock()
-	req := s.provingRequests[requestID]
+	defer s.mu.Unlock()
+
+	key := selectionsPrefix + record.RequestID + "/" + strconv.Itoa(record.Attempt)
+
+	return errors.Wrap(s.put(key, record), "error saving the selection record")
+}
+
+// ListSelectionRecords returns the selections of the request ordered by the attempt
+func (s *Storage) ListSelectionRecords(requestID common.RequestID) ([]common.SelectionRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make([]common.SelectionRecord, 0)
+	err := s.backend.Iterate([]byte(selectionsPrefix+requestID+"/"), func(_, value []byte) error {
+		var record common.SelectionRecord
+		if err := common.GobDecodeMessage(value, &record); err != nil {
+			return err
+		}
+
+		res = append(res, record)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing the selection records")
+	}
+
+	slices.SortFunc(res, func(a, b common.SelectionRecord) int {
+		return a.Attempt - b.Attempt
+	})
+
+	return res, nil
+}
+
+func (s *Storage) SaveJob(job common.ProvingJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return errors.Wrap(s.put(jobsPrefix+job.Request.ID, job), "error saving the proving job")
+}
+
+func (s *Storage) DeleteJob(requestID common.RequestID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return errors.Wrap(s.backend.Delete([]byte(jobsPrefix+requestID)), "error deleting the proving job")
+}
+
+func (s *Storage) ListJobs() ([]common.ProvingJob, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make([]common.ProvingJob, 0)
+	err := s.backend.Iterate([]byte(jobsPrefix), func(_, value []byte) error {
+		var job common.ProvingJob
+		if err := common.GobDecodeMessage(value, &job); err != nil {
+			return err
+		}
+
+		res = append(res, job)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing the proving jobs")
+	}
+
+	return res, nil
+}
+
+func (s *Storage) SavePayout(record common.PayoutRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return errors.Wrap(s.put(payoutsPrefix+record.RequestID, record), "error saving the payout")
+}
+
+func (s *Storage) GetPayout(requestID common.RequestID) (common.PayoutRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var record common.PayoutRecord
+	if err := s.get(payoutsPrefix+requestID, &record); err != nil {
+		return common.PayoutRecord{}, errors.Wrap(err, "error getting the payout")
+	}
+
+	return record, nil
+}
+
+func (s *Storage) ListPayouts() ([]common.PayoutRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make([]common.PayoutRecord, 0)
+	err := s.backend.Iterate([]byte(payoutsPrefix), func(_, value []byte) error {
+		var record common.PayoutRecord
+		if err := common.GobDecodeMessage(value, &record); err != nil {
+			return err
+		}
+
+		res = append(res, record)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing the payouts")
+	}
+
+	return res, nil
+}
+
+func (s *Storage) SaveReservation(reservation common.Reservation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return errors.Wrap(s.put(reservationsPrefix+reservation.RequestID, reservation), "error saving the reservation")
+}
+
+func (s *Storage) DeleteReservation(requestID common.RequestID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return errors.Wrap(s.backend.Delete([]byte(reservationsPrefix+requestID)), "error deleting the reservation")
+}
+
+func (s *Storage) ListReservations() ([]common.Reservation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make([]common.Reservation, 0)
+	err := s.backend.Iterate([]byte(reservationsPrefix), func(_, value []byte) error {
+		var reservation common.Reservation
+		if err := common.GobDecodeMessage(value, &reservation); err != nil {
+			return err
+		}
+
+		res = append(res, reservation)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing the reservations")
+	}
+
+	return res, nil
+}
+
+func (s *Storage) SavePendingProof(proof common.SignedProof) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return errors.Wrap(s.put(pendingProofsPrefix+proof.Request.ID, proof), "error saving the pending proof")
+}
+
+func (s *Storage) DeletePendingProof(requestID common.RequestID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return errors.Wrap(s.backend.Delete([]byte(pendingProofsPrefix+requestID)), "error deleting the pending proof")
+}
+
+func (s *Storage) ListPendingProofs() ([]common.SignedProof, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make([]common.SignedProof, 0)
+	err := s.backend.Iterate([]byte(pendingProofsPrefix), func(_, value []byte) error {
+		var proof common.SignedProof
+		if err := common.GobDecodeMessage(value, &proof); err != nil {
+			return err
+		}
+
+		res = append(res, proof)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing the pending proofs")
+	}
+
+	return res, nil
+}
+
+// archiveRequest keeps the request without its data in the finished ones
+func (s *Storage) archiveRequest(req common.RequestExtension) error {
+	req.Data = nil
+	if err := s.put(finishedPrefix+req.ID, req); err != nil {
+		return errors.Wrap(err, "error archiving the request")
+	}
+
+	return errors.Wrap(s.backend.Delete([]byte(requestsPrefix+req.ID)), "error deleting the request")
+}
+
+// GetRequestStatus looks up both the requests in progress and the finished ones
+func (s *Storage) GetRequestStatus(requestID common.RequestID) (common.RequestStatus, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	req, err := s.getRequest(requestID)
+	if err == nil {
+		return toRequestStatus(req), nil
+	}
+
+	if !errors.Is(err, errUnknownRequest) {
+		return common.RequestStatus{}, err
+	}
+
+	if err := s.get(finishedPrefix+requestID, &req); err != nil {
+		if errors.Is(err, connectors.ErrKeyNotFound) {
+			return common.RequestStatus{}, errUnknownRequest
+		}
+
+		return common.RequestStatus{}, err
+	}
+
+	return toRequestStatus(req), nil
+}
+
+func (s *Storage) GetFromResultsStorage(request common.RequestID) (common.FinalizedProof, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var result common.FinalizedProof
+	if err := s.get(resultsPrefix+request, &result); err != nil {
+		return common.FinalizedProof{}, errors.New("no proof in the results storage")
+	}
+
+	return result, nil
+}
+
+// SaveToResultsStorage caches a finalized proof fetched from the other nodes
+func (s *Storage) SaveToResultsStorage(result common.FinalizedProof) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return errors.Wrap(s.put(resultsPrefix+result.RequestID, result), "error saving the result")
+}
+
+func (s *Storage) AddValidationSignature(requestID common.RequestID, voterID, proverID peer.ID, isValid bool, signature []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	req, err := s.getRequest(requestID)
+	if err != nil {
+		return err
+	}
+
 	if _, ok := req.ValidationSignatures[proverID]; !ok {
 		req.ValidationSignatures[proverID] = make(map[peer.ID][]byte)
 	}
 
+	if _, ok := req.ValidationVotes[proverID]; !ok {
+		req.ValidationVotes[proverID] = make(map[peer.ID]bool)
+	}
+
 	req.ValidationSignatures[proverID][voterID] = signature
-	s.mu.Unlock()
+	req.ValidationVotes[proverID][voterID] = isValid
 
-	return nil
+	return s.putRequest(req)
 }
 
+// GetValidationSignatures returns the signatures of the votes that accepted the prover's proof, the contract only
+// counts them, and a rejecting signature doesn't recover to a validator of the accepted proof
 func (s *Storage) GetValidationSignatures(requestID common.RequestID, proverID peer.ID) ([][]byte, error) {
-	if !s.HasRequest(requestID) {
-		return nil, errUnknownRequest
-	}
-
-	res := make([][]byte, 0)
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	req := s.provingRequests[requestID]
-	for _, signature := range req.ValidationSignatures[proverID] {
-		res = append(res, signature)
+	req, err := s.getRequest(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([][]byte, 0)
+	for voterID, isValid := range req.ValidationVotes[proverID] {
+		if isValid {
+			res = append(res, req.ValidationSignatures[proverID][voterID])
+		}
 	}
 
 	return res, nil
@@ -193,47 +700,193 @@ func (s *Storage) GetRequests() map[common.RequestID]common.RequestExtension {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	return s.provingRequests
+	requests := make(map[common.RequestID]common.RequestExtension)
+	err := s.backend.Iterate([]byte(requestsPrefix), func(_, value []byte) error {
+		var req common.RequestExtension
+		if err := common.GobDecodeMessage(value, &req); err != nil {
+			return err
+		}
+
+		requests[req.ID] = req
+
+		return nil
+	})
+	if err != nil {
+		return make(map[common.RequestID]common.RequestExtension)
+	}
+
+	return requests
 }
 
 func (s *Storage) GetLatestProofs() map[string]common.ZKProof {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	return s.latestProofs
+	proofs := make(map[string]common.ZKProof)
+	err := s.backend.Iterate([]byte(latestProofsPrefix), func(key, value []byte) error {
+		var proof common.ZKProof
+		if err := common.GobDecodeMessage(value, &proof); err != nil {
+			return err
+		}
+
+		proofs[string(key[len(latestProofsPrefix):])] = proof
+
+		return nil
+	})
+	if err != nil {
+		return make(map[string]common.ZKProof)
+	}
+
+	return proofs
 }
 
-func (s *Storage) SetRequests(requests map[common.RequestID]common.RequestExtension) {
+func (s *Storage) SetRequests(requests map[common.RequestID]common.RequestExtension) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.provingRequests = requests
+	if err := s.deleteByPrefix(requestsPrefix); err != nil {
+		return errors.Wrap(err, "error deleting old requests")
+	}
+
+	for _, req := range requests {
+		if err := s.putRequest(req); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (s *Storage) SetLatestProofs(proofs map[string]common.ZKProof) {
+func (s *Storage) SetLatestProofs(proofs map[string]common.ZKProof) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.latestProofs = proofs
+	if err := s.deleteByPrefix(latestProofsPrefix); err != nil {
+		return errors.Wrap(err, "error deleting old latest proofs")
+	}
+
+	for consumerImage, proof := range proofs {
+		if err := s.put(latestProofsPrefix+consumerImage, proof); err != nil {
+			return errors.Wrap(err, "error saving the latest proof")
+		}
+	}
+
+	return nil
 }
 
+// GetStorageHash hashes the requests and the latest proofs in the key order. The stored gob values can't be hashed
+// as they are, gob writes the map entries in a random order, so the values are hashed in the deterministic
+// protobuf encoding of the sync protocol
 func (s *Storage) GetStorageHash() (string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	hasher := sha256.New()
-	b, err := common.GobEncodeMessage(s.provingRequests)
-	if err != nil {
-		return "", errors.Wrap(err, "error encoding proving requests")
+	write := func(key []byte, msg protobuf.Message) error {
+		b, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return err
+		}
+
+		// the lengths keep the boundaries between the keys and the values unambiguous
+		hasher.Write(binary.AppendUvarint(nil, uint64(len(key))))
+		hasher.Write(key)
+		hasher.Write(binary.AppendUvarint(nil, uint64(len(b))))
+		hasher.Write(b)
+
+		return nil
 	}
 
-	hasher.Write(b)
-	b, err = common.GobEncodeMessage(s.latestProofs)
+	err := s.backend.Iterate([]byte(requestsPrefix), func(key, value []byte) error {
+		var req common.RequestExtension
+		if err := common.GobDecodeMessage(value, &req); err != nil {
+			return err
+		}
+
+		return write(key, common.RequestExtensionToProto(req))
+	})
 	if err != nil {
-		return "", errors.Wrap(err, "error encoding latest proofs")
+		return "", errors.Wrap(err, "error hashing proving requests")
 	}
 
-	hasher.Write(b)
+	err = s.backend.Iterate([]byte(latestProofsPrefix), func(key, value []byte) error {
+		var proof common.ZKProof
+		if err := common.GobDecodeMessage(value, &proof); err != nil {
+			return err
+		}
+
+		return write(key, common.ZKProofToProto(proof))
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "error hashing latest proofs")
+	}
 
 	return base64.URLEncoding.EncodeToString(hasher.Sum(nil)), nil
 }
+
+func (s *Storage) getRequest(requestID common.RequestID) (common.RequestExtension, error) {
+	var req common.RequestExtension
+	if err := s.get(requestsPrefix+requestID, &req); err != nil {
+		if errors.Is(err, connectors.ErrKeyNotFound) {
+			return common.RequestExtension{}, errUnknownRequest
+		}
+
+		return common.RequestExtension{}, err
+	}
+
+	if req.Proofs == nil {
+		req.Proofs = make(map[peer.ID]common.ZKProof)
+	}
+
+	if req.ValidationSignatures == nil {
+		req.ValidationSignatures = make(map[peer.ID]map[peer.ID][]byte)
+	}
+
+	if req.ValidationVotes == nil {
+		req.ValidationVotes = make(map[peer.ID]map[peer.ID]bool)
+	}
+
+	return req, nil
+}
+
+func (s *Storage) putRequest(req common.RequestExtension) error {
+	return errors.Wrap(s.put(requestsPrefix+req.ID, req), "error saving the request")
+}
+
+func (s *Storage) get(key string, dest any) error {
+	b, err := s.backend.Get([]byte(key))
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(common.GobDecodeMessage(b, dest), "error decoding a value")
+}
+
+func (s *Storage) put(key string, value any) error {
+	b, err := common.GobEncodeMessage(value)
+	if err != nil {
+		return errors.Wrap(err, "error encoding a value")
+	}
+
+	return s.backend.Put([]byte(key), b)
+}
+
+func (s *Storage) deleteByPrefix(prefix string) error {
+	keys := make([][]byte, 0)
+	err := s.backend.Iterate([]byte(prefix), func(key, _ []byte) error {
+		keys = append(keys, append([]byte(nil), key...))
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := s.backend.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,26 +3,33 @@ package sync
 import (
 	"bufio"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
 	"github.com/dimazhornyk/generic-proving-network/internal/logic"
+	"github.com/dimazhornyk/generic-proving-network/internal/metrics"
+	"github.com/dimazhornyk/generic-proving-network/proto"
 	"github.com/libp2p/go-libp2p/core"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+	"io"
 	"log/slog"
 	"time"
 )
 
-const delim = '\n'
 const minConnectionsForSync = 3
+const maxSyncMessageSize = 64 << 20
 
 type InitialSyncer struct {
 	host        host.Host
 	protocolID  core.ProtocolID
 	storage     *logic.Storage
 	connections *logic.ConnectionHolder
+	ledger      *logic.ConsumerLedger
 }
 
 type chanResp struct {
@@ -31,11 +38,12 @@ type chanResp struct {
 	err    error
 }
 
-func NewInitialSyncer(cfg *common.Config, connections *logic.ConnectionHolder, storage *logic.Storage, host host.Host) *InitialSyncer {
+func NewInitialSyncer(cfg *common.Config, connections *logic.ConnectionHolder, storage *logic.Storage, host host.Host, ledger *logic.ConsumerLedger) *InitialSyncer {
 	return &InitialSyncer{
 		host:        host,
 		storage:     storage,
 		connections: connections,
+		ledger:      ledger,
 		protocolID:  cfg.SyncProtocolID,
 	}
 }
@@ -60,6 +68,7 @@ outer:
 
 	if is.connections.Len() == 0 { // first node in the network, no need to sync
 		slog.Info("first node, no need to sync")
+		metrics.InitialSyncOutcomes.WithLabelValues(metrics.SyncFirstNode).Inc()
 
 		return nil
 	}
@@ -74,14 +83,19 @@ outer:
 			continue
 		} else {
 			if err := is.checkStateChecksum(ctx, peers); err != nil {
+				metrics.InitialSyncOutcomes.WithLabelValues(metrics.SyncFailed).Inc()
+
 				return errors.Wrap(err, "error checking state checksum")
 			}
 			slog.Info("state checksum is correct")
+			metrics.InitialSyncOutcomes.WithLabelValues(metrics.SyncSucceeded).Inc()
 
 			return nil
 		}
 	}
 
+	metrics.InitialSyncOutcomes.WithLabelValues(metrics.SyncFailed).Inc()
+
 	return errors.New("unable to sync storage")
 }
 
@@ -91,16 +105,16 @@ func (is *InitialSyncer) syncStorage(ctx context.Context, p peer.ID) error {
 		return errors.Wrap(err, "error on creating a new stream")
 	}
 
-	if err := is.sendInitMessage(bufio.NewWriter(stream)); err != nil {
+	if err := is.writeMessage(ctx, bufio.NewWriter(stream), Message{Type: InitSync}); err != nil {
 		return errors.Wrap(err, "error sending init message")
 	}
 
 	reader := bufio.NewReader(stream)
-	if err := is.readRequestsData(reader); err != nil {
+	if err := is.readRequestsData(ctx, reader); err != nil {
 		return errors.Wrap(err, "error reading requests data")
 	}
 
-	if err := is.readLatestProofsData(reader); err != nil {
+	if err := is.readLatestProofsData(ctx, reader); err != nil {
 		return errors.Wrap(err, "error reading latest proofs data")
 	}
 
@@ -137,14 +151,14 @@ func (is *InitialSyncer) checkStateChecksum(ctx context.Context, peers []peer.ID
 		return errors.Wrap(err, "error getting storage hash")
 	}
 
-	if err := is.requestHashes(streams); err != nil {
+	if err := is.requestHashes(ctx, streams); err != nil {
 		return errors.Wrap(err, "error requesting hashes")
 	}
 
 	ch := make(chan chanResp, len(streams))
 	for _, s := range streams {
 		s := s // avoid capturing loop variable
-		go is.listenForStorageHash(s, ch)
+		go is.listenForStorageHash(ctx, s, ch)
 	}
 
 	for i := 0; i < len(streams); i++ {
@@ -161,35 +175,22 @@ func (is *InitialSyncer) checkStateChecksum(ctx context.Context, peers []peer.ID
 	return nil
 }
 
-func (is *InitialSyncer) requestHashes(streams []network.Stream) error {
-	msg := Message{Type: RequestStorageHash}
-	b, err := common.GobEncodeMessage(msg)
-	if err != nil {
-		return errors.Wrap(err, "error encoding a message")
-	}
-
-	b = append(b, delim)
+func (is *InitialSyncer) requestHashes(ctx context.Context, streams []network.Stream) error {
 	for _, stream := range streams {
-		wr := bufio.NewWriter(stream)
-		if _, err := wr.Write(b); err != nil {
-			return errors.Wrap(err, "error writing to a stream")
-		}
-
-		if err := wr.Flush(); err != nil {
-			return errors.Wrap(err, "error flushing a stream")
+		if err := is.writeMessage(ctx, bufio.NewWriter(stream), Message{Type: RequestStorageHash}); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-func (is *InitialSyncer) listenForStorageHash(stream network.Stream, ch chan chanResp) {
-	reader := bufio.NewReader(stream)
+func (is *InitialSyncer) listenForStorageHash(ctx context.Context, stream network.Stream, ch chan chanResp) {
 	jobResponse := chanResp{
 		peerID: stream.Conn().RemotePeer().String(),
 	}
 
-	b, err := reader.ReadBytes(delim)
+	resp, err := readMessage(ctx, bufio.NewReader(stream))
 	if err != nil {
 		jobResponse.err = errors.Wrap(err, "error reading hash from a stream")
 		ch <- jobResponse
@@ -197,105 +198,73 @@ func (is *InitialSyncer) listenForStorageHash(stream network.Stream, ch chan cha
 		return
 	}
 
-	var resp Message
-	if err := common.GobDecodeMessage(b, &resp); err != nil {
-		jobResponse.err = errors.Wrap(err, "error decoding a message")
+	if resp.Type != SendStorageHash {
+		jobResponse.err = errors.Errorf("unexpected message type: %d", resp.Type)
 		ch <- jobResponse
 
 		return
 	}
 
-	hash := resp.Payload.(string)
-	jobResponse.hash = hash
+	jobResponse.hash = resp.StorageHash
 	ch <- jobResponse
 }
 
-func (is *InitialSyncer) sendInitMessage(wr *bufio.Writer) error {
-	msg := Message{Type: InitSync}
-	b, err := common.GobEncodeMessage(msg)
-	if err != nil {
-		return errors.Wrap(err, "error encoding a message")
-	}
-
-	b = append(b, delim)
-	if _, err := wr.Write(b); err != nil {
-		return errors.Wrap(err, "error writing to a stream")
-	}
-
-	if err := wr.Flush(); err != nil {
-		return errors.Wrap(err, "error flushing a stream")
-	}
-
-	return nil
-}
-
-func (is *InitialSyncer) readRequestsData(r *bufio.Reader) error {
-	b, err := r.ReadBytes(delim)
+func (is *InitialSyncer) readRequestsData(ctx context.Context, r *bufio.Reader) error {
+	resp, err := readMessage(ctx, r)
 	if err != nil {
 		return errors.Wrap(err, "error reading requests from a stream")
 	}
 
-	var resp Message
-	if err := common.GobDecodeMessage(b, &resp); err != nil {
-		return errors.Wrap(err, "error decoding a message")
-	}
-
-	requests, ok := resp.Payload.(map[common.RequestID]common.RequestExtension)
-	if !ok {
+	if resp.Requests == nil {
 		return errors.New("error decoding requests data")
 	}
 
-	is.storage.SetRequests(requests)
+	if err := is.storage.SetRequests(resp.Requests); err != nil {
+		return errors.Wrap(err, "error saving requests data")
+	}
 
-	return nil
+	return errors.Wrap(is.ledger.ReserveSynced(resp.Requests), "error reserving the synced rewards")
 }
 
-func (is *InitialSyncer) readLatestProofsData(r *bufio.Reader) error {
-	b, err := r.ReadBytes(delim)
+func (is *InitialSyncer) readLatestProofsData(ctx context.Context, r *bufio.Reader) error {
+	resp, err := readMessage(ctx, r)
 	if err != nil {
-		return errors.Wrap(err, "error reading requests from a stream")
-	}
-
-	var resp Message
-	if err := common.GobDecodeMessage(b, &resp); err != nil {
-		return errors.Wrap(err, "error decoding a message")
+		return errors.Wrap(err, "error reading latest proofs from a stream")
 	}
 
-	latestProofs, ok := resp.Payload.(map[string]common.ZKProof)
-	if !ok {
+	if resp.LatestProofs == nil {
 		return errors.New("error decoding latest proofs data")
 	}
 
-	is.storage.SetLatestProofs(latestProofs)
-
-	return nil
+	return errors.Wrap(is.storage.SetLatestProofs(resp.LatestProofs), "error saving latest proofs data")
 }
 
 func (is *InitialSyncer) ProvideData() {
 	is.host.SetStreamHandler(is.protocolID, func(stream network.Stream) {
 		slog.Info("new stream", slog.String("peerID", stream.Conn().RemotePeer().String()))
 		rw := bufio.NewReadWriter(bufio.NewReader(stream), bufio.NewWriter(stream))
+		ctx := context.Background()
 
 		for {
-			data, err := rw.ReadBytes(delim)
+			msg, err := readMessage(ctx, rw.Reader)
 			if err != nil {
-				slog.Error("error reading from a stream", slog.String("error", err.Error()))
-
-				return
-			}
-
-			var msg Message
-			if err := common.GobDecodeMessage(data, &msg); err != nil {
-				slog.Error("error decoding a message", slog.String("error", err.Error()))
+				if errors.Is(err, connectors.ErrUnsupportedVersion) {
+					slog.Warn("rejecting sync message with unsupported wire version",
+						slog.String("peerID", stream.Conn().RemotePeer().String()),
+						slog.String("error", err.Error()),
+					)
+				} else if !errors.Is(err, io.EOF) {
+					slog.Error("error reading from a stream", slog.String("error", err.Error()))
+				}
 
 				return
 			}
 
 			switch msg.Type {
 			case InitSync:
-				err = is.shareStorage(rw)
+				err = is.shareStorage(ctx, rw)
 			case RequestStorageHash:
-				err = is.sendStorageHash(rw)
+				err = is.sendStorageHash(ctx, rw)
 			default:
 				slog.Error("unknown message type", slog.Int("type", int(msg.Type)))
 
@@ -311,75 +280,96 @@ func (is *InitialSyncer) ProvideData() {
 	})
 }
 
-func (is *InitialSyncer) shareStorage(rw *bufio.ReadWriter) error {
+func (is *InitialSyncer) shareStorage(ctx context.Context, rw *bufio.ReadWriter) error {
 	requests := is.storage.GetRequests()
 	proofs := is.storage.GetLatestProofs()
 
 	msg := Message{
-		Type:    SendData,
-		Payload: requests,
+		Type:     SendData,
+		Requests: requests,
 	}
 
-	b, err := common.GobEncodeMessage(msg)
-	if err != nil {
-		return errors.Wrap(err, "error encoding a message")
+	if err := is.writeMessage(ctx, rw.Writer, msg); err != nil {
+		return err
 	}
 
-	b = append(b, delim)
-	if _, err := rw.Write(b); err != nil {
-		return errors.Wrap(err, "error writing to a stream")
+	msg = Message{
+		Type:         SendData,
+		LatestProofs: proofs,
 	}
 
-	if err := rw.Flush(); err != nil {
-		return errors.Wrap(err, "error flushing a stream")
+	return is.writeMessage(ctx, rw.Writer, msg)
+}
+
+func (is *InitialSyncer) sendStorageHash(ctx context.Context, rw *bufio.ReadWriter) error {
+	hash, err := is.storage.GetStorageHash()
+	if err != nil {
+		return errors.Wrap(err, "error getting storage hash")
 	}
 
-	msg = Message{
-		Type:    SendData,
-		Payload: proofs,
+	msg := Message{
+		Type:        SendStorageHash,
+		StorageHash: hash,
 	}
 
-	b, err = common.GobEncodeMessage(msg)
+	return is.writeMessage(ctx, rw.Writer, msg)
+}
+
+// writeMessage writes a length-prefixed envelope, so the messages don't need a delimiter
+func (is *InitialSyncer) writeMessage(ctx context.Context, wr *bufio.Writer, msg Message) error {
+	b, err := connectors.EncodeEnvelope(ctx, proto.MessageType_MESSAGE_TYPE_SYNC, is.host.ID(), msg.toProto())
 	if err != nil {
 		return errors.Wrap(err, "error encoding a message")
 	}
 
-	b = append(b, delim)
-	if _, err := rw.Write(b); err != nil {
+	if _, err := wr.Write(binary.AppendUvarint(nil, uint64(len(b)))); err != nil {
 		return errors.Wrap(err, "error writing to a stream")
 	}
 
-	if err := rw.Flush(); err != nil {
+	if _, err := wr.Write(b); err != nil {
+		return errors.Wrap(err, "error writing to a stream")
+	}
+
+	if err := wr.Flush(); err != nil {
 		return errors.Wrap(err, "error flushing a stream")
 	}
 
 	return nil
 }
 
-func (is *InitialSyncer) sendStorageHash(rw *bufio.ReadWriter) error {
-	hash, err := is.storage.GetStorageHash()
+func readMessage(ctx context.Context, r *bufio.Reader) (Message, error) {
+	size, err := binary.ReadUvarint(r)
 	if err != nil {
-		return errors.Wrap(err, "error getting storage hash")
+		return Message{}, err
 	}
 
-	msg := Message{
-		Type:    SendStorageHash,
-		Payload: hash,
+	if size > maxSyncMessageSize {
+		return Message{}, errors.Errorf("message is too large: %d bytes", size)
 	}
 
-	b, err := common.GobEncodeMessage(msg)
+	// the buffer grows with the data actually received, not with the size announced by the peer
+	b, err := io.ReadAll(io.LimitReader(r, int64(size)))
 	if err != nil {
-		return errors.Wrap(err, "error encoding a message")
+		return Message{}, errors.Wrap(err, "error reading a message")
 	}
 
-	b = append(b, delim)
-	if _, err := rw.Write(b); err != nil {
-		return errors.Wrap(err, "error writing to a stream")
+	if uint64(len(b)) != size {
+		return Message{}, errors.Wrap(io.ErrUnexpectedEOF, "error reading a message")
 	}
 
-	if err := rw.Flush(); err != nil {
-		return errors.Wrap(err, "error flushing a stream")
+	_, envelope, err := connectors.DecodeEnvelope(ctx, b)
+	if err != nil {
+		return Message{}, err
 	}
 
-	return nil
+	if envelope.GetType() != proto.MessageType_MESSAGE_TYPE_SYNC {
+		return Message{}, errors.Errorf("unexpected message type: %s", envelope.GetType().String())
+	}
+
+	var msg proto.SyncMessage
+	if err := protobuf.Unmarshal(envelope.GetPayload(), &msg); err != nil {
+		return Message{}, errors.Wrap(err, "error decoding a sync message")
+	}
+
+	return messageFromProto(&msg)
 }
@@ -1,5 +1,11 @@
 package sync
 
+import (
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/proto"
+	"github.com/pkg/errors"
+)
+
 type MessageType int
 
 const (
@@ -9,7 +15,70 @@ const (
 	SendStorageHash
 )
 
+// Message is sent over the sync protocol as proto.SyncMessage, only one of the payload fields is set
 type Message struct {
-	Type    MessageType
-	Payload any
+	Type         MessageType
+	Requests     map[common.RequestID]common.RequestExtension
+	LatestProofs map[string]common.ZKProof
+	StorageHash  string
+}
+
+func (m Message) toProto() *proto.SyncMessage {
+	res := &proto.SyncMessage{
+		Type: proto.SyncMessageType(m.Type),
+	}
+
+	switch {
+	case m.Requests != nil:
+		requests := make([]*proto.RequestExtension, 0, len(m.Requests))
+		for _, req := range m.Requests {
+			requests = append(requests, common.RequestExtensionToProto(req))
+		}
+
+		res.Payload = &proto.SyncMessage_Requests{Requests: &proto.RequestsData{Requests: requests}}
+	case m.LatestProofs != nil:
+		proofs := make(map[string]*proto.ZKProof, len(m.LatestProofs))
+		for consumerImage, proof := range m.LatestProofs {
+			proofs[consumerImage] = common.ZKProofToProto(proof)
+		}
+
+		res.Payload = &proto.SyncMessage_LatestProofs{LatestProofs: &proto.LatestProofsData{Proofs: proofs}}
+	case m.StorageHash != "":
+		res.Payload = &proto.SyncMessage_StorageHash{StorageHash: m.StorageHash}
+	}
+
+	return res
+}
+
+func messageFromProto(msg *proto.SyncMessage) (Message, error) {
+	msgType := MessageType(msg.GetType())
+	if msgType < InitSync || msgType > SendStorageHash {
+		return Message{}, errors.Errorf("unknown sync message type: %d", msg.GetType())
+	}
+
+	res := Message{
+		Type: msgType,
+	}
+
+	switch payload := msg.GetPayload().(type) {
+	case *proto.SyncMessage_Requests:
+		res.Requests = make(map[common.RequestID]common.RequestExtension, len(payload.Requests.GetRequests()))
+		for _, r := range payload.Requests.GetRequests() {
+			req, err := common.RequestExtensionFromProto(r)
+			if err != nil {
+				return Message{}, errors.Wrap(err, "error decoding a request")
+			}
+
+			res.Requests[req.ID] = req
+		}
+	case *proto.SyncMessage_LatestProofs:
+		res.LatestProofs = make(map[string]common.ZKProof, len(payload.LatestProofs.GetProofs()))
+		for consumerImage, proof := range payload.LatestProofs.GetProofs() {
+			res.LatestProofs[consumerImage] = common.ZKProofFromProto(proof)
+		}
+	case *proto.SyncMessage_StorageHash:
+		res.StorageHash = payload.StorageHash
+	}
+
+	return res, nil
 }
@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"log/slog"
+	"math/big"
+)
+
+// VoteWeigher decides how much a single vote counts in a voting
+type VoteWeigher struct {
+	byStake      bool
+	cap          *big.Int
+	participants *NetworkParticipants
+}
+
+func NewVoteWeigher(cfg *common.Config, participants *NetworkParticipants) *VoteWeigher {
+	return &VoteWeigher{
+		byStake:      cfg.VoteWeighting == common.VoteWeightingStake,
+		cap:          cfg.StakeWeightCapWei(),
+		participants: participants,
+	}
+}
+
+// Weight is 1 for every voter in the counting mode, otherwise it's the voter's stake limited by the cap,
+// so the provers without stake don't affect the result
+func (w *VoteWeigher) Weight(voter peer.ID) *big.Int {
+	if !w.byStake {
+		return big.NewInt(1)
+	}
+
+	addr, err := common.PeerIDToEthAddress(voter)
+	if err != nil {
+		slog.Error("error converting peer ID to ethereum address", slog.String("err", err.Error()))
+
+		return new(big.Int)
+	}
+
+	stake := w.participants.GetStake(ethcommon.HexToAddress(addr))
+	if w.cap != nil && stake.Cmp(w.cap) > 0 {
+		return new(big.Int).Set(w.cap)
+	}
+
+	return stake
+}
@@ -1,78 +1,315 @@
 package logic
 
 import (
+	"context"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	"math/big"
+	"sync"
+	"time"
 )
 
 var errUnknownVotingKey = errors.New("unknown request")
-var errVotingHasDrawn = errors.New("voting has drawn")
+var ErrNoQuorum = errors.New("voting hasn't reached the quorum")
+var ErrNoThreshold = errors.New("no value has reached the voting threshold")
+
+// Vote keeps the proof of the vote, so the conflicting votes can be used as evidence against the voter
+type Vote[V comparable] struct {
+	Value     V
+	Signature []byte // signature of the vote or the signed pubsub message carrying it
+}
+
+// Equivocation is a pair of the conflicting votes of the same voter
+type Equivocation[V comparable] struct {
+	Voter  peer.ID
+	First  Vote[V]
+	Second Vote[V]
+}
 
 // inmemory voting is a temporary solution, it should be replaced with a more persistent approach
 type Voting[K, V comparable] struct {
 	VotingKey K
-	Votes     map[peer.ID]V
+	Votes     map[peer.ID][]Vote[V] // every distinct value of the voter, more than one means equivocation
+
+	changed chan struct{} // wakes up the round awaiting the result
+	closed  bool          // the result is taken, the late votes don't open a new round
+}
+
+// Equivocators returns the voters that voted for more than one value
+func (v Voting[K, V]) Equivocators() []peer.ID {
+	res := make([]peer.ID, 0)
+	for voter, votes := range v.Votes {
+		if len(votes) > 1 {
+			res = append(res, voter)
+		}
+	}
+
+	return res
 }
 
-type VotingMap[K, V comparable] map[K]Voting[K, V]
+// TieBreak reports whether a wins over b when both have the same weight, it has to give the same answer on every node
+type TieBreak[K, V comparable] func(key K, a, b V) bool
+
+type VotingMap[K, V comparable] struct {
+	m        map[K]Voting[K, V]
+	mu       sync.Mutex
+	tieBreak TieBreak[K, V]
+}
+
+func NewVotingMap[K, V comparable](tieBreak TieBreak[K, V]) *VotingMap[K, V] {
+	return &VotingMap[K, V]{
+		m:        make(map[K]Voting[K, V]),
+		tieBreak: tieBreak,
+	}
+}
+
+// Add reports whether the voting has already existed, and returns the conflicting pair if the voter
+// has voted for a different value before. The votes that come after Close are only checked for equivocation
+func (m *VotingMap[K, V]) Add(key K, voter peer.ID, value V, signature []byte) (bool, *Equivocation[V]) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	voting, ok := m.m[key]
+	if ok && voting.closed {
+		return true, equivocation(voting.Votes[voter], voter, Vote[V]{Value: value, Signature: signature})
+	}
 
-func (m VotingMap[K, V]) Add(key K, voter peer.ID, value V) bool {
-	_, ok := m[key]
 	if !ok {
-		m[key] = Voting[K, V]{
+		m.m[key] = Voting[K, V]{
 			VotingKey: key,
-			Votes:     make(map[peer.ID]V),
+			Votes:     make(map[peer.ID][]Vote[V]),
+			changed:   make(chan struct{}, 1),
+		}
+	}
+
+	vote := Vote[V]{
+		Value:     value,
+		Signature: signature,
+	}
+
+	votes := m.m[key].Votes[voter]
+	for _, v := range votes {
+		if v.Value == value {
+			return ok, nil
+		}
+	}
+
+	m.m[key].Votes[voter] = append(votes, vote)
+	select {
+	case m.m[key].changed <- struct{}{}:
+	default:
+	}
+
+	return ok, equivocation(votes, voter, vote)
+}
+
+// equivocation returns the conflicting pair if the vote differs from the voter's recorded ones
+func equivocation[V comparable](votes []Vote[V], voter peer.ID, vote Vote[V]) *Equivocation[V] {
+	for _, v := range votes {
+		if v.Value == vote.Value {
+			return nil
 		}
 	}
 
-	m[key].Votes[voter] = value
+	if len(votes) == 0 {
+		return nil
+	}
 
-	return ok
+	return &Equivocation[V]{
+		Voter:  voter,
+		First:  votes[0],
+		Second: vote,
+	}
 }
 
-func (m VotingMap[K, V]) Get(key K) (Voting[K, V], error) {
-	value, ok := m[key]
+func (m *VotingMap[K, V]) Get(key K) (Voting[K, V], error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	value, ok := m.m[key]
 	if !ok {
 		return Voting[K, V]{}, errUnknownVotingKey
 	}
 
-	return value, nil
+	votes := make(map[peer.ID][]Vote[V], len(value.Votes))
+	for voter, v := range value.Votes {
+		votes[voter] = append([]Vote[V](nil), v...)
+	}
+
+	return Voting[K, V]{
+		VotingKey: value.VotingKey,
+		Votes:     votes,
+	}, nil
+}
+
+// Close keeps the votes for the equivocation checks, but the late votes won't open a new round
+func (m *VotingMap[K, V]) Close(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if voting, ok := m.m[key]; ok {
+		voting.closed = true
+		m.m[key] = voting
+	}
+}
+
+func (m *VotingMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.m, key)
 }
 
-func (m VotingMap[K, V]) Delete(key K) {
-	delete(m, key)
+// RoundParams describe who decides the voting and when it's decided
+type RoundParams struct {
+	Voters    []peer.ID // eligible voters, the votes of the others aren't counted
+	Quorum    float64   // share of the eligible weight that has to vote
+	Threshold float64   // share of the voted weight the winner needs
+	Deadline  time.Duration
+	Weight    func(peer.ID) *big.Int
 }
 
-func (m VotingMap[K, V]) GetWinner(key K) (*V, error) {
-	voting, ok := m[key]
+type tally[V comparable] struct {
+	leader       V
+	leaderWeight *big.Int
+	runnerUp     *big.Int
+	voted        *big.Int
+	total        *big.Int
+	allVoted     bool
+}
+
+// Await blocks until the round is decided: everyone has voted, or the quorum has voted and the leader
+// can't lose neither the lead nor the threshold with the remaining votes, or the deadline has passed
+func (m *VotingMap[K, V]) Await(ctx context.Context, key K, params RoundParams) (*V, error) {
+	m.mu.Lock()
+	voting, ok := m.m[key]
+	m.mu.Unlock()
 	if !ok {
 		return nil, errUnknownVotingKey
 	}
 
-	opts := make(map[V]int)
-	for _, v := range voting.Votes {
-		opts[v]++
+	timer := time.NewTimer(params.Deadline)
+	defer timer.Stop()
+
+	for {
+		t, err := m.tally(key, params)
+		if err != nil {
+			return nil, err
+		}
+
+		if t.allVoted || t.isDecided(params) {
+			return t.result(params)
+		}
+
+		select {
+		case <-voting.changed:
+		case <-timer.C:
+			t, err := m.tally(key, params)
+			if err != nil {
+				return nil, err
+			}
+
+			return t.result(params)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// tally sums the weights of the eligible voters per value, the equivocators lose their weight completely
+func (m *VotingMap[K, V]) tally(key K, params RoundParams) (tally[V], error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	voting, ok := m.m[key]
+	if !ok {
+		return tally[V]{}, errUnknownVotingKey
+	}
+
+	t := tally[V]{
+		leaderWeight: new(big.Int),
+		runnerUp:     new(big.Int),
+		voted:        new(big.Int),
+		total:        new(big.Int),
+		allVoted:     true,
 	}
 
-	var winner V
-	var maxVotes int
-	var hasEqual bool
+	opts := make(map[V]*big.Int)
+	for _, voter := range params.Voters {
+		votes := voting.Votes[voter]
+		if len(votes) == 0 {
+			t.allVoted = false
+		}
+
+		if len(votes) > 1 {
+			continue
+		}
 
-	for v, count := range opts {
-		if count == maxVotes {
-			hasEqual = true
+		weight := params.Weight(voter)
+		t.total.Add(t.total, weight)
+		if len(votes) == 0 {
+			continue
 		}
 
-		if count > maxVotes {
-			maxVotes = count
-			winner = v
-			hasEqual = false
+		t.voted.Add(t.voted, weight)
+		if _, ok := opts[votes[0].Value]; !ok {
+			opts[votes[0].Value] = new(big.Int)
 		}
+		opts[votes[0].Value].Add(opts[votes[0].Value], weight)
+	}
+
+	var hasLeader bool
+	for v, weight := range opts {
+		cmp := weight.Cmp(t.leaderWeight)
+		if !hasLeader || cmp > 0 || (cmp == 0 && m.tieBreak(key, v, t.leader)) {
+			if hasLeader {
+				t.runnerUp = t.leaderWeight
+			}
+			t.leader, t.leaderWeight, hasLeader = v, weight, true
+		} else if weight.Cmp(t.runnerUp) > 0 {
+			t.runnerUp = weight
+		}
+	}
+
+	return t, nil
+}
+
+// isDecided checks that the result can't change even if all the remaining voters vote against the leader.
+// The threshold is a share of the voted weight like in result, the remaining votes can only add to it
+func (t tally[V]) isDecided(params RoundParams) bool {
+	if !atLeastShare(t.voted, t.total, params.Quorum) || t.leaderWeight.Sign() == 0 {
+		return false
+	}
+
+	remaining := new(big.Int).Sub(t.total, t.voted)
+	if t.leaderWeight.Cmp(new(big.Int).Add(t.runnerUp, remaining)) <= 0 {
+		return false
 	}
 
-	if hasEqual {
-		return nil, errVotingHasDrawn
+	maxVoted := new(big.Int).Add(t.voted, remaining)
+
+	return atLeastShare(t.leaderWeight, maxVoted, params.Threshold)
+}
+
+func (t tally[V]) result(params RoundParams) (*V, error) {
+	if t.voted.Sign() == 0 {
+		return nil, nil
+	}
+
+	if !atLeastShare(t.voted, t.total, params.Quorum) {
+		return nil, ErrNoQuorum
 	}
 
-	return &winner, nil
+	if !atLeastShare(t.leaderWeight, t.voted, params.Threshold) {
+		return nil, ErrNoThreshold
+	}
+
+	return &t.leader, nil
+}
+
+// atLeastShare reports whether part >= share * whole
+func atLeastShare(part, whole *big.Int, share float64) bool {
+	required := new(big.Rat).Mul(new(big.Rat).SetFloat64(share), new(big.Rat).SetInt(whole))
+
+	return new(big.Rat).SetInt(part).Cmp(required) >= 0
 }
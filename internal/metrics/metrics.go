@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "gpn"
+
+var (
+	PubSubMessagesReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "pubsub",
+		Name:      "messages_received_total",
+		Help:      "Number of the pubsub messages received, per topic",
+	}, []string{"topic"})
+
+	PubSubMessagesRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "pubsub",
+		Name:      "messages_rejected_total",
+		Help:      "Number of the pubsub messages rejected before handling, per topic and reason",
+	}, []string{"topic", "reason"})
+
+	RequestToProofDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "proving",
+		Name:      "request_to_proof_seconds",
+		Help:      "Time from the proving request creation to the proof being received",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 14),
+	}, []string{"consumer"})
+
+	ProvingTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "proving",
+		Name:      "timeouts_total",
+		Help:      "Number of the selected provers that missed the proving deadline, per prover",
+	}, []string{"consumer", "peer"})
+
+	ValidationVoteLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "voting",
+		Name:      "validation_vote_latency_seconds",
+		Help:      "Time from the proof being received to the validation vote being received",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{"consumer"})
+
+	ProverCallDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "prover",
+		Name:      "call_duration_seconds",
+		Help:      "Duration of the calls to the prover containers",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 16),
+	}, []string{"consumer", "operation"})
+
+	ProverCallErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "prover",
+		Name:      "call_errors_total",
+		Help:      "Number of the failed calls to the prover containers",
+	}, []string{"consumer", "operation"})
+
+	EvidenceRecorded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "evidence",
+		Name:      "recorded_total",
+		Help:      "Number of the misbehaviour evidence records, per type",
+	}, []string{"type"})
+
+	InitialSyncOutcomes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "sync",
+		Name:      "initial_sync_total",
+		Help:      "Outcomes of the initial storage sync",
+	}, []string{"outcome"})
+
+	EthereumSubmissions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ethereum",
+		Name:      "submissions_total",
+		Help:      "Number of the transactions submitted to the contract, per method and outcome",
+	}, []string{"method", "outcome"})
+
+	EthereumGasUsed = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ethereum",
+		Name:      "gas_used",
+		Help:      "Gas used by the mined transactions",
+		Buckets:   prometheus.ExponentialBuckets(21000, 2, 10),
+	}, []string{"method"})
+)
+
+const (
+	OperationProve    = "prove"
+	OperationValidate = "validate"
+
+	RejectedNonParticipant = "non_participant"
+	RejectedDecoding       = "decoding"
+	RejectedVersion        = "version"
+	RejectedAuthor         = "author"
+
+	SyncSucceeded = "succeeded"
+	SyncFirstNode = "first_node"
+	SyncFailed    = "failed"
+
+	OutcomeMined    = "mined"
+	OutcomeReverted = "reverted"
+	OutcomeFailed   = "failed"
+	OutcomeReplaced = "replaced"
+)
@@ -3,45 +3,64 @@ package presenters
 import (
 	"context"
 	"github.com/dimazhornyk/generic-proving-network/internal/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
 	"github.com/dimazhornyk/generic-proving-network/internal/logic"
 	"github.com/dimazhornyk/generic-proving-network/proto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"log/slog"
+	"math/big"
+	"slices"
 )
 
 type API struct {
 	proto.UnimplementedProvingNetworkServiceServer
-	service *logic.Service
+	service  *logic.Service
+	events   *logic.EventBus
+	evidence *logic.EvidenceCollector
+	ethereum *connectors.Ethereum
+	payouts  *logic.PayoutTracker
 }
 
-func NewAPI(service *logic.Service) *API {
+func NewAPI(service *logic.Service, events *logic.EventBus, evidence *logic.EvidenceCollector, eth *connectors.Ethereum, payouts *logic.PayoutTracker) *API {
 	return &API{
-		service: service,
+		service:  service,
+		events:   events,
+		evidence: evidence,
+		ethereum: eth,
+		payouts:  payouts,
 	}
 }
 
 func (a *API) ComputeProof(ctx context.Context, req *proto.ComputeProofRequest) (*emptypb.Empty, error) {
-	r := toCommonRequest(req)
+	r, err := toCommonRequest(req)
+	if err != nil {
+		return &emptypb.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	if err := a.service.InitiateProofCalculation(ctx, r); err != nil {
 		slog.Error("error initiating proof calculation: ", slog.String("err", err.Error()))
 
-		return &emptypb.Empty{}, status.Error(codes.Internal, err.Error())
+		return &emptypb.Empty{}, status.Error(verificationErrorCode(err), err.Error())
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
-func (a *API) GetProof(_ context.Context, req *proto.GetProofRequest) (*proto.GetProofResponse, error) {
-	proof, err := a.service.GetProof(req.GetRequestId())
+func (a *API) GetProof(ctx context.Context, req *proto.GetProofRequest) (*proto.GetProofResponse, error) {
+	proof, err := a.service.GetProof(ctx, req.GetRequestId(), req.GetConsumerImage())
 	if err != nil {
 		if errors.Is(err, logic.ErrNoProof) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
 
+		if errors.Is(err, logic.ErrRequestFailed) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -52,12 +71,256 @@ func (a *API) GetProof(_ context.Context, req *proto.GetProofRequest) (*proto.Ge
 	}, nil
 }
 
-func toCommonRequest(req *proto.ComputeProofRequest) common.ComputeProofRequest {
+func (a *API) GetRequestStatus(_ context.Context, req *proto.GetRequestStatusRequest) (*proto.GetRequestStatusResponse, error) {
+	requestStatus, err := a.service.GetRequestStatus(req.GetRequestId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	attempts := common.Map(requestStatus.Attempts, func(attempt common.ProvingAttempt) *proto.ProvingAttempt {
+		return &proto.ProvingAttempt{
+			PeerId:       attempt.PeerID.String(),
+			ProofId:      attempt.ProofID,
+			ValidVotes:   uint32(attempt.ValidVotes),
+			InvalidVotes: uint32(attempt.InvalidVotes),
+			TimedOut:     attempt.TimedOut,
+		}
+	})
+
+	return &proto.GetRequestStatusResponse{
+		RequestId:        requestStatus.RequestID,
+		Phase:            toProtoPhase(requestStatus.Phase),
+		Attempts:         attempts,
+		SubmissionTxHash: requestStatus.SubmissionTxHash,
+	}, nil
+}
+
+func (a *API) WatchRequest(req *proto.WatchRequestRequest, stream proto.ProvingNetworkService_WatchRequestServer) error {
+	replay, ch, cancel := a.events.Subscribe(req.GetRequestId())
+	defer func() {
+		cancel()
+	}()
+
+	// sent counts the events of the request sent so far, a replay after falling behind skips them
+	var sent int
+	for {
+		for _, event := range replay[min(sent, len(replay)):] {
+			if err := stream.Send(toProtoEvent(event)); err != nil {
+				return err
+			}
+			sent++
+
+			if event.Type.IsTerminal() {
+				return nil
+			}
+		}
+		replay = nil
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-ch:
+			if !ok {
+				// the stream fell behind, the events it missed are replayed from the history
+				cancel()
+				replay, ch, cancel = a.events.Subscribe(req.GetRequestId())
+
+				continue
+			}
+
+			if err := stream.Send(toProtoEvent(event)); err != nil {
+				return err
+			}
+			sent++
+
+			if event.Type.IsTerminal() {
+				return nil
+			}
+		}
+	}
+}
+
+func (a *API) ListEvidence(_ context.Context, req *proto.ListEvidenceRequest) (*proto.ListEvidenceResponse, error) {
+	evidence, err := a.evidence.List(req.GetRequestId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.ListEvidenceResponse{
+		Evidence: common.Map(evidence, toProtoEvidence),
+	}, nil
+}
+
+func (a *API) GetSelectionAudit(_ context.Context, req *proto.GetSelectionAuditRequest) (*proto.GetSelectionAuditResponse, error) {
+	records, err := a.service.GetSelectionRecords(req.GetRequestId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.GetSelectionAuditResponse{
+		Selections: common.Map(records, toProtoSelectionRecord),
+	}, nil
+}
+
+func (a *API) ListOutbox(_ context.Context, req *proto.ListOutboxRequest) (*proto.ListOutboxResponse, error) {
+	entries := a.ethereum.ListOutbox()
+	if req.GetUnfinishedOnly() {
+		entries = slices.DeleteFunc(entries, func(entry common.OutboxEntry) bool {
+			return entry.State.IsFinal()
+		})
+	}
+
+	return &proto.ListOutboxResponse{
+		Entries: common.Map(entries, toProtoOutboxEntry),
+	}, nil
+}
+
+func (a *API) GetEarnings(_ context.Context, _ *proto.GetEarningsRequest) (*proto.GetEarningsResponse, error) {
+	earnings, err := a.payouts.Earnings()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.GetEarningsResponse{
+		Consumers: common.Map(earnings, func(e common.Earnings) *proto.ConsumerEarnings {
+			return &proto.ConsumerEarnings{
+				ConsumerAddress: e.ConsumerAddress,
+				Expected:        weiString(e.Expected),
+				Claimable:       weiString(e.Claimable),
+				Claimed:         weiString(e.Claimed),
+			}
+		}),
+	}, nil
+}
+
+func verificationErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, logic.ErrInvalidConsumerSignature):
+		return codes.Unauthenticated
+	case errors.Is(err, logic.ErrUnknownConsumer):
+		return codes.PermissionDenied
+	case errors.Is(err, logic.ErrConsumerImageMismatch), errors.Is(err, logic.ErrInsufficientBalance):
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+func toCommonRequest(req *proto.ComputeProofRequest) (common.ComputeProofRequest, error) {
+	reward, ok := new(big.Int).SetString(req.GetReward(), 10)
+	if !ok || reward.Sign() < 0 || reward.BitLen() > 256 {
+		return common.ComputeProofRequest{}, errors.Errorf("invalid reward %q, expected a uint256 amount in wei", req.GetReward())
+	}
+
 	return common.ComputeProofRequest{
 		ID:              req.GetRequestId(),
 		ConsumerImage:   req.GetConsumerImage(),
 		ConsumerAddress: req.GetConsumerAddress(),
 		Data:            req.GetData(),
 		Signature:       req.GetSignature(),
+		Reward:          reward,
+	}, nil
+}
+
+func toProtoPhase(phase common.RequestPhase) proto.RequestPhase {
+	switch phase {
+	case common.PhaseSelectingProver:
+		return proto.RequestPhase_REQUEST_PHASE_SELECTING_PROVER
+	case common.PhaseProving:
+		return proto.RequestPhase_REQUEST_PHASE_PROVING
+	case common.PhaseValidating:
+		return proto.RequestPhase_REQUEST_PHASE_VALIDATING
+	case common.PhaseFinalized:
+		return proto.RequestPhase_REQUEST_PHASE_FINALIZED
+	case common.PhaseSubmitted:
+		return proto.RequestPhase_REQUEST_PHASE_SUBMITTED
+	case common.PhaseFailed:
+		return proto.RequestPhase_REQUEST_PHASE_FAILED
+	default:
+		return proto.RequestPhase_REQUEST_PHASE_UNKNOWN
+	}
+}
+
+func toProtoEvent(event common.RequestEvent) *proto.RequestEvent {
+	return &proto.RequestEvent{
+		RequestId: event.RequestID,
+		Type:      proto.RequestEventType(event.Type), // both enums are declared in the same order
+		PeerId:    event.PeerID.String(),
+		ProofId:   event.ProofID,
+		IsValid:   event.IsValid,
+		TxHash:    event.TxHash,
+		Timestamp: event.Timestamp,
+	}
+}
+
+func toProtoEvidence(evidence common.Evidence) *proto.Evidence {
+	return &proto.Evidence{
+		Id:        evidence.ID,
+		Type:      proto.EvidenceType(evidence.Type), // both enums are declared in the same order
+		Offender:  evidence.Offender.String(),
+		RequestId: evidence.RequestID,
+		ProverId:  evidence.ProverID.String(),
+		Votes: common.Map(evidence.Votes, func(vote common.SignedVote) *proto.SignedVote {
+			return &proto.SignedVote{
+				VoterId:   vote.VoterID.String(),
+				IsValid:   vote.IsValid,
+				Signature: vote.Signature,
+			}
+		}),
+		SignedMessage:    evidence.SignedMessage,
+		Reporter:         evidence.Reporter.String(),
+		CreatedAt:        evidence.CreatedAt,
+		SubmissionTxHash: evidence.SubmissionTx,
+		ProofHash:        evidence.ProofHash,
+	}
+}
+
+func toProtoSelectionRecord(record common.SelectionRecord) *proto.SelectionRecord {
+	return &proto.SelectionRecord{
+		Attempt: uint32(record.Attempt),
+		Source:  record.Source,
+		Contributions: common.Map(record.Contributions, func(c common.RandomnessContribution) *proto.RandomnessContribution {
+			return &proto.RandomnessContribution{
+				Source:   c.Source,
+				Value:    c.Value,
+				Withheld: c.Withheld,
+			}
+		}),
+		Seed:       record.Seed,
+		Candidates: common.Map(record.Candidates, peer.ID.String),
+		Selected:   record.Selected.String(),
+		CreatedAt:  record.CreatedAt,
+		Verified:   common.VerifySelection(record),
+		Policy:     record.Policy,
+		Weights:    record.Weights,
+	}
+}
+
+func toProtoOutboxEntry(entry common.OutboxEntry) *proto.OutboxEntry {
+	return &proto.OutboxEntry{
+		Id:          entry.ID,
+		Method:      entry.Method,
+		State:       proto.OutboxState(entry.State), // both enums are declared in the same order
+		HasNonce:    entry.HasNonce,
+		Nonce:       entry.Nonce,
+		GasPrice:    weiString(entry.GasPrice),
+		GasTipCap:   weiString(entry.GasTipCap),
+		GasFeeCap:   weiString(entry.GasFeeCap),
+		TxHashes:    entry.TxHashes,
+		MinedTxHash: entry.MinedTx,
+		Attempts:    uint32(entry.Attempts),
+		LastError:   entry.LastError,
+		CreatedAt:   entry.CreatedAt,
+		SentAt:      entry.SentAt,
+		FinishedAt:  entry.FinishedAt,
+		FillNonce:   entry.FillNonce,
 	}
 }
+
+func weiString(amount *big.Int) string {
+	if amount == nil {
+		return ""
+	}
+
+	return amount.String()
+}
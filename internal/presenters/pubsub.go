@@ -6,7 +6,8 @@ import (
 	"github.com/dimazhornyk/generic-proving-network/internal/connectors"
 	"github.com/dimazhornyk/generic-proving-network/internal/logic"
 	"github.com/dimazhornyk/generic-proving-network/internal/logic/handlers"
-	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/dimazhornyk/generic-proving-network/internal/metrics"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
@@ -20,16 +21,19 @@ type Listener struct {
 	statusUpdatesHandler *handlers.StatusUpdatesHandler
 	proofsHandler        *handlers.ProofsHandler
 	networkParticipants  *logic.NetworkParticipants
+	commitReveal         *logic.CommitReveal
 	hostID               peer.ID
 }
 
-func NewListener(pubsub *connectors.PubSub, vh *handlers.VotingHandler, rh *handlers.ProvingRequestsHandler, sh *handlers.StatusUpdatesHandler, np *logic.NetworkParticipants, host host.Host) *Listener {
+func NewListener(pubsub *connectors.PubSub, vh *handlers.VotingHandler, rh *handlers.ProvingRequestsHandler, sh *handlers.StatusUpdatesHandler, ph *handlers.ProofsHandler, np *logic.NetworkParticipants, commitReveal *logic.CommitReveal, host host.Host) *Listener {
 	return &Listener{
 		pubsub:               pubsub,
 		votingHandler:        vh,
 		requestsHandler:      rh,
 		statusUpdatesHandler: sh,
+		proofsHandler:        ph,
 		networkParticipants:  np,
+		commitReveal:         commitReveal,
 		hostID:               host.ID(),
 	}
 }
@@ -40,6 +44,7 @@ func (l *Listener) Listen(ctx context.Context) {
 		l.ListenProvingRequests,
 		l.ListenVoting,
 		l.ListenProofs,
+		l.ListenRandomness,
 	}
 
 	errs := make(chan error, len(funcs))
@@ -77,25 +82,25 @@ func (l *Listener) ListenStateUpdates(ctx context.Context) error {
 	for {
 		pubsubMsg, err := subscription.Next(ctx)
 		if err != nil {
-			slog.Error("error getting next message from subscription", err)
+			slog.Error("error getting next message from subscription", slog.String("err", err.Error()))
 
 			continue
 		}
 
-		if !l.isNetworkParticipant(pubsubMsg.ReceivedFrom) {
-			slog.Info("received message from non-network participant", slog.String("peer", pubsubMsg.ReceivedFrom.String()))
-
+		metrics.PubSubMessagesReceived.WithLabelValues(subscription.Topic()).Inc()
+		author, ok := l.authorOf(subscription.Topic(), pubsubMsg)
+		if !ok {
 			continue
 		}
 
 		var msg common.StatusMessage
-		if err := common.GobDecodeMessage(pubsubMsg.Data, &msg); err != nil {
-			slog.Error("error unmarshalling state update message", err)
+		if _, err := connectors.DecodeMessage(ctx, author, pubsubMsg.Data, &msg); err != nil {
+			rejectUndecodable(subscription.Topic(), author, err)
 
 			continue
 		}
 
-		go l.statusUpdatesHandler.Handle(pubsubMsg.ReceivedFrom, msg)
+		go l.statusUpdatesHandler.Handle(author, msg)
 	}
 }
 
@@ -108,25 +113,27 @@ func (l *Listener) ListenProvingRequests(ctx context.Context) error {
 	for {
 		pubsubMsg, err := subscription.Next(ctx)
 		if err != nil {
-			slog.Error("error getting next message from subscription", err)
+			slog.Error("error getting next message from subscription", slog.String("err", err.Error()))
 
 			continue
 		}
 
-		if !l.isNetworkParticipant(pubsubMsg.ReceivedFrom) {
-			slog.Info("received message from non-network participant", slog.String("peer", pubsubMsg.ReceivedFrom.String()))
-
+		metrics.PubSubMessagesReceived.WithLabelValues(subscription.Topic()).Inc()
+		author, ok := l.authorOf(subscription.Topic(), pubsubMsg)
+		if !ok {
 			continue
 		}
 
 		var msg common.ProvingRequestMessage
-		if err := common.GobDecodeMessage(pubsubMsg.Data, &msg); err != nil {
-			slog.Error("error unmarshalling proving request message", err)
+		msgCtx, err := connectors.DecodeMessage(ctx, author, pubsubMsg.Data, &msg)
+		if err != nil {
+			rejectUndecodable(subscription.Topic(), author, err)
 
 			continue
 		}
 
-		go l.requestsHandler.Handle(ctx, msg)
+		msgCtx = logic.WithSignedMessage(msgCtx, signedMessage(pubsubMsg))
+		go l.requestsHandler.Handle(msgCtx, author, msg)
 	}
 }
 
@@ -139,25 +146,27 @@ func (l *Listener) ListenProofs(ctx context.Context) error {
 	for {
 		pubsubMsg, err := subscription.Next(ctx)
 		if err != nil {
-			slog.Error("error getting next message from subscription", err)
+			slog.Error("error getting next message from subscription", slog.String("err", err.Error()))
 
 			continue
 		}
 
-		if !l.isNetworkParticipant(pubsubMsg.ReceivedFrom) {
-			slog.Info("received message from non-network participant", slog.String("peer", pubsubMsg.ReceivedFrom.String()))
-
+		metrics.PubSubMessagesReceived.WithLabelValues(subscription.Topic()).Inc()
+		author, ok := l.authorOf(subscription.Topic(), pubsubMsg)
+		if !ok {
 			continue
 		}
 
 		var msg common.ProofSubmissionMessage
-		if err := common.GobDecodeMessage(pubsubMsg.Data, &msg); err != nil {
-			slog.Error("error unmarshalling voting message", err)
+		msgCtx, err := connectors.DecodeMessage(ctx, author, pubsubMsg.Data, &msg)
+		if err != nil {
+			rejectUndecodable(subscription.Topic(), author, err)
 
 			continue
 		}
 
-		go l.proofsHandler.Handle(ctx, pubsubMsg.ReceivedFrom, msg)
+		msgCtx = logic.WithSignedMessage(msgCtx, signedMessage(pubsubMsg))
+		go l.proofsHandler.Handle(msgCtx, author, msg)
 	}
 }
 
@@ -170,38 +179,138 @@ func (l *Listener) ListenVoting(ctx context.Context) error {
 	for {
 		pubsubMsg, err := subscription.Next(ctx)
 		if err != nil {
-			slog.Error("error getting next message from subscription", err)
+			slog.Error("error getting next message from subscription", slog.String("err", err.Error()))
 
 			continue
 		}
 
-		if !l.isNetworkParticipant(pubsubMsg.ReceivedFrom) {
-			slog.Info("received message from non-network participant", slog.String("peer", pubsubMsg.ReceivedFrom.String()))
-
+		metrics.PubSubMessagesReceived.WithLabelValues(subscription.Topic()).Inc()
+		author, ok := l.authorOf(subscription.Topic(), pubsubMsg)
+		if !ok {
 			continue
 		}
 
 		var msg common.VotingMessage
-		if err := common.GobDecodeMessage(pubsubMsg.Data, &msg); err != nil {
-			slog.Error("error unmarshalling voting message", err)
+		msgCtx, err := connectors.DecodeMessage(ctx, author, pubsubMsg.Data, &msg)
+		if err != nil {
+			rejectUndecodable(subscription.Topic(), author, err)
+
+			continue
+		}
+
+		msgCtx = logic.WithSignedMessage(msgCtx, signedMessage(pubsubMsg))
+		go l.votingHandler.Handle(msgCtx, author, msg)
+	}
+}
+
+func (l *Listener) ListenRandomness(ctx context.Context) error {
+	subscription, err := l.pubsub.Subscribe(common.RandomnessTopic)
+	if err != nil {
+		return errors.Wrap(err, "error subscribing to randomness topic")
+	}
+
+	for {
+		pubsubMsg, err := subscription.Next(ctx)
+		if err != nil {
+			slog.Error("error getting next message from subscription", slog.String("err", err.Error()))
+
+			continue
+		}
+
+		metrics.PubSubMessagesReceived.WithLabelValues(subscription.Topic()).Inc()
+		author, ok := l.authorOf(subscription.Topic(), pubsubMsg)
+		if !ok {
+			continue
+		}
+
+		var msg common.RandomnessMessage
+		if _, err := connectors.DecodeMessage(ctx, author, pubsubMsg.Data, &msg); err != nil {
+			rejectUndecodable(subscription.Topic(), author, err)
 
 			continue
 		}
 
-		go l.votingHandler.Handle(ctx, pubsubMsg.ReceivedFrom, msg)
+		l.commitReveal.Handle(author, msg)
+	}
+}
+
+// rejectUndecodable logs and counts the messages that couldn't be decoded
+func rejectUndecodable(topic string, peerID peer.ID, err error) {
+	reason := metrics.RejectedDecoding
+	if errors.Is(err, connectors.ErrSenderMismatch) {
+		reason = metrics.RejectedAuthor
+		slog.Warn("rejecting message with mismatching sender",
+			slog.String("topic", topic),
+			slog.String("peer", peerID.String()),
+			slog.String("err", err.Error()),
+		)
+	} else if errors.Is(err, connectors.ErrUnsupportedVersion) {
+		reason = metrics.RejectedVersion
+		slog.Warn("rejecting message with unsupported wire version",
+			slog.String("topic", topic),
+			slog.String("peer", peerID.String()),
+			slog.String("err", err.Error()),
+		)
+	} else {
+		slog.Error("error decoding message",
+			slog.String("topic", topic),
+			slog.String("peer", peerID.String()),
+			slog.String("err", err.Error()),
+		)
+	}
+
+	metrics.PubSubMessagesRejected.WithLabelValues(topic, reason).Inc()
+}
+
+// signedMessage keeps the author's signature together with the message, so it can be used as evidence
+func signedMessage(pubsubMsg *pubsub.Message) []byte {
+	raw, err := pubsubMsg.Message.Marshal()
+	if err != nil {
+		slog.Error("error encoding signed message", slog.String("err", err.Error()))
+
+		return nil
 	}
+
+	return raw
+}
+
+// authorOf returns the peer that signed the message, gossipsub relays messages, so it isn't necessarily the one we got it from
+func (l *Listener) authorOf(topic string, pubsubMsg *pubsub.Message) (peer.ID, bool) {
+	author := pubsubMsg.GetFrom()
+	if err := author.Validate(); err != nil {
+		slog.Error("received message without a valid author",
+			slog.String("topic", topic),
+			slog.String("receivedFrom", pubsubMsg.ReceivedFrom.String()),
+		)
+		metrics.PubSubMessagesRejected.WithLabelValues(topic, metrics.RejectedAuthor).Inc()
+
+		return "", false
+	}
+
+	if !l.isNetworkParticipant(author) {
+		slog.Info("received message from non-network participant",
+			slog.String("peer", author.String()),
+			slog.String("receivedFrom", pubsubMsg.ReceivedFrom.String()),
+		)
+		metrics.PubSubMessagesRejected.WithLabelValues(topic, metrics.RejectedNonParticipant).Inc()
+
+		return "", false
+	}
+
+	return author, true
 }
 
+// isNetworkParticipant checks that the peer is registered as a prover on-chain
 func (l *Listener) isNetworkParticipant(peerID peer.ID) bool {
-	addr, err := common.PeerIDToEthAddress(peerID)
+	registered, err := l.networkParticipants.IsRegisteredPeer(peerID)
 	if err != nil {
-		slog.Error("error converting peer ID to ethereum address", err)
+		slog.Error("error checking peer registration", slog.String("err", err.Error()))
 
 		return false
 	}
 
-	if !l.networkParticipants.IsKnownProver(ethcommon.HexToAddress(addr)) {
-		slog.Error("error: peer is not a network participant", err)
+	if !registered {
+		slog.Error("error: peer is not a network participant", slog.String("peer", peerID.String()))
 
 		return false
 	}
@@ -25,6 +25,17 @@ const _ = grpc.SupportPackageIsVersion7
 type ProvingNetworkServiceClient interface {
 	ComputeProof(ctx context.Context, in *ComputeProofRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*GetProofResponse, error)
+	GetRequestStatus(ctx context.Context, in *GetRequestStatusRequest, opts ...grpc.CallOption) (*GetRequestStatusResponse, error)
+	// WatchRequest replays the events observed so far and streams the new ones until the request is finished
+	WatchRequest(ctx context.Context, in *WatchRequestRequest, opts ...grpc.CallOption) (ProvingNetworkService_WatchRequestClient, error)
+	// ListEvidence returns the evidence of misbehaviour collected by the node
+	ListEvidence(ctx context.Context, in *ListEvidenceRequest, opts ...grpc.CallOption) (*ListEvidenceResponse, error)
+	// GetSelectionAudit returns the recorded prover selections of the request, each one repeated by the node
+	GetSelectionAudit(ctx context.Context, in *GetSelectionAuditRequest, opts ...grpc.CallOption) (*GetSelectionAuditResponse, error)
+	// ListOutbox returns the contract calls kept by the node's transaction outbox, the oldest first
+	ListOutbox(ctx context.Context, in *ListOutboxRequest, opts ...grpc.CallOption) (*ListOutboxResponse, error)
+	// GetEarnings returns the rewards of the proofs submitted by the node, by consumer
+	GetEarnings(ctx context.Context, in *GetEarningsRequest, opts ...grpc.CallOption) (*GetEarningsResponse, error)
 }
 
 type provingNetworkServiceClient struct {
@@ -53,12 +64,100 @@ func (c *provingNetworkServiceClient) GetProof(ctx context.Context, in *GetProof
 	return out, nil
 }
 
+func (c *provingNetworkServiceClient) GetRequestStatus(ctx context.Context, in *GetRequestStatusRequest, opts ...grpc.CallOption) (*GetRequestStatusResponse, error) {
+	out := new(GetRequestStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvingNetworkService/GetRequestStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provingNetworkServiceClient) WatchRequest(ctx context.Context, in *WatchRequestRequest, opts ...grpc.CallOption) (ProvingNetworkService_WatchRequestClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProvingNetworkService_ServiceDesc.Streams[0], "/proto.ProvingNetworkService/WatchRequest", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &provingNetworkServiceWatchRequestClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProvingNetworkService_WatchRequestClient interface {
+	Recv() (*RequestEvent, error)
+	grpc.ClientStream
+}
+
+type provingNetworkServiceWatchRequestClient struct {
+	grpc.ClientStream
+}
+
+func (x *provingNetworkServiceWatchRequestClient) Recv() (*RequestEvent, error) {
+	m := new(RequestEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *provingNetworkServiceClient) ListEvidence(ctx context.Context, in *ListEvidenceRequest, opts ...grpc.CallOption) (*ListEvidenceResponse, error) {
+	out := new(ListEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvingNetworkService/ListEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provingNetworkServiceClient) GetSelectionAudit(ctx context.Context, in *GetSelectionAuditRequest, opts ...grpc.CallOption) (*GetSelectionAuditResponse, error) {
+	out := new(GetSelectionAuditResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvingNetworkService/GetSelectionAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provingNetworkServiceClient) ListOutbox(ctx context.Context, in *ListOutboxRequest, opts ...grpc.CallOption) (*ListOutboxResponse, error) {
+	out := new(ListOutboxResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvingNetworkService/ListOutbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provingNetworkServiceClient) GetEarnings(ctx context.Context, in *GetEarningsRequest, opts ...grpc.CallOption) (*GetEarningsResponse, error) {
+	out := new(GetEarningsResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvingNetworkService/GetEarnings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProvingNetworkServiceServer is the server API for ProvingNetworkService service.
 // All implementations must embed UnimplementedProvingNetworkServiceServer
 // for forward compatibility
 type ProvingNetworkServiceServer interface {
 	ComputeProof(context.Context, *ComputeProofRequest) (*emptypb.Empty, error)
 	GetProof(context.Context, *GetProofRequest) (*GetProofResponse, error)
+	GetRequestStatus(context.Context, *GetRequestStatusRequest) (*GetRequestStatusResponse, error)
+	// WatchRequest replays the events observed so far and streams the new ones until the request is finished
+	WatchRequest(*WatchRequestRequest, ProvingNetworkService_WatchRequestServer) error
+	// ListEvidence returns the evidence of misbehaviour collected by the node
+	ListEvidence(context.Context, *ListEvidenceRequest) (*ListEvidenceResponse, error)
+	// GetSelectionAudit returns the recorded prover selections of the request, each one repeated by the node
+	GetSelectionAudit(context.Context, *GetSelectionAuditRequest) (*GetSelectionAuditResponse, error)
+	// ListOutbox returns the contract calls kept by the node's transaction outbox, the oldest first
+	ListOutbox(context.Context, *ListOutboxRequest) (*ListOutboxResponse, error)
+	// GetEarnings returns the rewards of the proofs submitted by the node, by consumer
+	GetEarnings(context.Context, *GetEarningsRequest) (*GetEarningsResponse, error)
 	mustEmbedUnimplementedProvingNetworkServiceServer()
 }
 
@@ -72,6 +171,24 @@ func (UnimplementedProvingNetworkServiceServer) ComputeProof(context.Context, *C
 func (UnimplementedProvingNetworkServiceServer) GetProof(context.Context, *GetProofRequest) (*GetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProof not implemented")
 }
+func (UnimplementedProvingNetworkServiceServer) GetRequestStatus(context.Context, *GetRequestStatusRequest) (*GetRequestStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRequestStatus not implemented")
+}
+func (UnimplementedProvingNetworkServiceServer) WatchRequest(*WatchRequestRequest, ProvingNetworkService_WatchRequestServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRequest not implemented")
+}
+func (UnimplementedProvingNetworkServiceServer) ListEvidence(context.Context, *ListEvidenceRequest) (*ListEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvidence not implemented")
+}
+func (UnimplementedProvingNetworkServiceServer) GetSelectionAudit(context.Context, *GetSelectionAuditRequest) (*GetSelectionAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSelectionAudit not implemented")
+}
+func (UnimplementedProvingNetworkServiceServer) ListOutbox(context.Context, *ListOutboxRequest) (*ListOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutbox not implemented")
+}
+func (UnimplementedProvingNetworkServiceServer) GetEarnings(context.Context, *GetEarningsRequest) (*GetEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEarnings not implemented")
+}
 func (UnimplementedProvingNetworkServiceServer) mustEmbedUnimplementedProvingNetworkServiceServer() {}
 
 // UnsafeProvingNetworkServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -121,6 +238,117 @@ func _ProvingNetworkService_GetProof_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProvingNetworkService_GetRequestStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequestStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvingNetworkServiceServer).GetRequestStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvingNetworkService/GetRequestStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvingNetworkServiceServer).GetRequestStatus(ctx, req.(*GetRequestStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvingNetworkService_WatchRequest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProvingNetworkServiceServer).WatchRequest(m, &provingNetworkServiceWatchRequestServer{stream})
+}
+
+type ProvingNetworkService_WatchRequestServer interface {
+	Send(*RequestEvent) error
+	grpc.ServerStream
+}
+
+type provingNetworkServiceWatchRequestServer struct {
+	grpc.ServerStream
+}
+
+func (x *provingNetworkServiceWatchRequestServer) Send(m *RequestEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProvingNetworkService_ListEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvingNetworkServiceServer).ListEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvingNetworkService/ListEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvingNetworkServiceServer).ListEvidence(ctx, req.(*ListEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvingNetworkService_GetSelectionAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSelectionAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvingNetworkServiceServer).GetSelectionAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvingNetworkService/GetSelectionAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvingNetworkServiceServer).GetSelectionAudit(ctx, req.(*GetSelectionAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvingNetworkService_ListOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvingNetworkServiceServer).ListOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvingNetworkService/ListOutbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvingNetworkServiceServer).ListOutbox(ctx, req.(*ListOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvingNetworkService_GetEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvingNetworkServiceServer).GetEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvingNetworkService/GetEarnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvingNetworkServiceServer).GetEarnings(ctx, req.(*GetEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProvingNetworkService_ServiceDesc is the grpc.ServiceDesc for ProvingNetworkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -136,7 +364,33 @@ var ProvingNetworkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProof",
 			Handler:    _ProvingNetworkService_GetProof_Handler,
 		},
+		{
+			MethodName: "GetRequestStatus",
+			Handler:    _ProvingNetworkService_GetRequestStatus_Handler,
+		},
+		{
+			MethodName: "ListEvidence",
+			Handler:    _ProvingNetworkService_ListEvidence_Handler,
+		},
+		{
+			MethodName: "GetSelectionAudit",
+			Handler:    _ProvingNetworkService_GetSelectionAudit_Handler,
+		},
+		{
+			MethodName: "ListOutbox",
+			Handler:    _ProvingNetworkService_ListOutbox_Handler,
+		},
+		{
+			MethodName: "GetEarnings",
+			Handler:    _ProvingNetworkService_GetEarnings_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRequest",
+			Handler:       _ProvingNetworkService_WatchRequest_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "generic-proving-network.proto",
 }